@@ -26,7 +26,7 @@ func main() {
 		showVersion = flag.Bool("version", false, "显示版本信息")
 		showHelp    = flag.Bool("help", false, "显示帮助信息")
 		configFile  = flag.String("config", "", "配置文件路径")
-		transport   = flag.String("transport", "", "传输模式 (stdio|sse|http)")
+		transport   = flag.String("transport", "", "传输模式 (stdio|sse|http|websocket)")
 		authToken   = flag.String("auth-token", "", "Bearer认证令牌")
 		enableAuth  = flag.Bool("enable-auth", false, "启用认证")
 		port        = flag.Int("port", 0, "服务器端口")
@@ -71,6 +71,11 @@ func main() {
 		cfg.Logging.Level = *logLevel
 	}
 	
+	// stdio模式下标准输出用于传输协议消息，日志只能写到标准错误
+	if cfg.MCP.Transport == "stdio" {
+		cfg.Logging.Output = "stderr"
+	}
+	
 	// TODO: 如果指定了配置文件，从文件加载配置
 	if *configFile != "" {
 		fmt.Printf("Warning: Config file loading not implemented yet: %s\n", *configFile)
@@ -153,7 +158,7 @@ func showUsage() {
   -version              显示版本信息并退出
   -help                 显示此帮助信息并退出
   -config <file>        指定配置文件路径 (暂未实现)
-  -transport <mode>     传输模式 (stdio|sse|http, 默认: stdio)
+  -transport <mode>     传输模式 (stdio|sse|http|websocket, 默认: stdio)
   -auth-token <token>   Bearer认证令牌 (自动启用认证)
   -enable-auth          启用认证 (需要配置认证参数)
   -port <port>          服务器端口 (仅HTTP/SSE模式, 默认: 8080)
@@ -180,9 +185,10 @@ func showUsage() {
     MCP_SERVER_NAME             服务器名称 (默认: ai-sre-mcp-server)
     MCP_SERVER_VERSION          服务器版本 (默认: 1.0.0)
     MCP_PROTOCOL_VERSION        协议版本 (默认: 2024-11-05)
-    MCP_TRANSPORT               传输模式 (stdio|sse|http, 默认: stdio)
+    MCP_TRANSPORT               传输模式 (stdio|sse|http|websocket, 默认: stdio)
     MCP_REQUEST_TIMEOUT         请求超时时间 (默认: 60s)
     MCP_MAX_CONCURRENT_REQUESTS 最大并发请求数 (默认: 100)
+    MCP_WS_PING_INTERVAL        WebSocket保活ping间隔 (默认: 30s)
 
   认证配置:
     MCP_AUTH_ENABLED            是否启用认证 (默认: false)
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// UnaryServerInterceptor gRPC 一元调用鉴权拦截器，与 HTTP 端点使用相同的鉴权配置
+func (am *AuthMiddleware) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := am.authorizeGRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor gRPC 流式调用鉴权拦截器
+func (am *AuthMiddleware) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := am.authorizeGRPC(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// grpcCredentialKeys 鉴权读取的 metadata 键，其余 metadata 不参与鉴权
+var grpcCredentialKeys = []string{"authorization", "x-api-key"}
+
+// authorizeGRPC 校验 gRPC 调用的鉴权信息
+// 凭据只从 metadata 的 authorization、x-api-key 读取，键名与 HTTP 头一致；
+// 客户端IP只取连接的对端地址，不信任 metadata 中 x-forwarded-for 等可由客户端伪造的值
+func (am *AuthMiddleware) authorizeGRPC(ctx context.Context, fullMethod string) error {
+	if !am.config.Enabled {
+		return nil
+	}
+
+	r := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: fullMethod},
+		Header: make(http.Header),
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range grpcCredentialKeys {
+			for _, value := range md.Get(key) {
+				r.Header.Add(key, value)
+			}
+		}
+	}
+
+	var clientIP string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(clientIP); err == nil {
+			clientIP = host
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"client_ip": clientIP,
+		"method":    fullMethod,
+		"auth_type": am.config.Type,
+	}).Debug("gRPC authentication attempt")
+
+	ok, forbidden, reason := am.authenticate(r, clientIP)
+	if ok {
+		return nil
+	}
+	am.logAuthFailure(clientIP, reason)
+	if forbidden {
+		return status.Error(codes.PermissionDenied, "IP not allowed")
+	}
+	return status.Error(codes.Unauthenticated, reason)
+}
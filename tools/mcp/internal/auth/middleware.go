@@ -42,32 +42,14 @@ func (am *AuthMiddleware) Handler(next http.Handler) http.Handler {
 			"auth_type": am.config.Type,
 		}).Debug("Authentication attempt")
 
-		// 检查IP白名单
-		if len(am.config.AllowedIPs) > 0 && !am.isIPAllowed(clientIP) {
-			am.logAuthFailure(clientIP, "IP not in whitelist")
-			http.Error(w, "Forbidden: IP not allowed", http.StatusForbidden)
-			return
-		}
-
-		// 根据鉴权类型进行验证
-		var authResult bool
-		var authError string
-
-		switch am.config.Type {
-		case "bearer":
-			authResult, authError = am.validateBearerToken(r)
-		case "api_key":
-			authResult, authError = am.validateAPIKey(r)
-		case "basic":
-			authResult, authError = am.validateBasicAuth(r)
-		default:
-			authError = fmt.Sprintf("unsupported auth type: %s", am.config.Type)
-		}
-
-		if !authResult {
-			am.logAuthFailure(clientIP, authError)
+		if ok, forbidden, reason := am.authenticate(r, clientIP); !ok {
+			am.logAuthFailure(clientIP, reason)
+			if forbidden {
+				http.Error(w, "Forbidden: IP not allowed", http.StatusForbidden)
+				return
+			}
 			w.Header().Set("WWW-Authenticate", am.getAuthChallenge())
-			http.Error(w, "Unauthorized: "+authError, http.StatusUnauthorized)
+			http.Error(w, "Unauthorized: "+reason, http.StatusUnauthorized)
 			return
 		}
 
@@ -81,6 +63,28 @@ func (am *AuthMiddleware) Handler(next http.Handler) http.Handler {
 	})
 }
 
+// authenticate 检查IP白名单并按鉴权类型验证凭据，HTTP 端点和 gRPC 服务共用
+// forbidden 为 true 表示客户端IP不在白名单中
+func (am *AuthMiddleware) authenticate(r *http.Request, clientIP string) (ok bool, forbidden bool, reason string) {
+	// 检查IP白名单
+	if len(am.config.AllowedIPs) > 0 && !am.isIPAllowed(clientIP) {
+		return false, true, "IP not in whitelist"
+	}
+
+	// 根据鉴权类型进行验证
+	switch am.config.Type {
+	case "bearer":
+		ok, reason = am.validateBearerToken(r)
+	case "api_key":
+		ok, reason = am.validateAPIKey(r)
+	case "basic":
+		ok, reason = am.validateBasicAuth(r)
+	default:
+		reason = fmt.Sprintf("unsupported auth type: %s", am.config.Type)
+	}
+	return ok, false, reason
+}
+
 // validateBearerToken 验证Bearer Token
 func (am *AuthMiddleware) validateBearerToken(r *http.Request) (bool, string) {
 	authHeader := r.Header.Get("Authorization")
@@ -0,0 +1,105 @@
+package caller
+
+import (
+	"context"
+	"sync"
+)
+
+// identityContextKey 调用方标识在上下文中的键
+type identityContextKey struct{}
+
+// WithIdentity 将调用方标识放入上下文
+// 标识为发起请求的客户端（对端IP），同一客户端新建会话或换用其他传输方式时标识不变
+func WithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// FromContext 从上下文中获取调用方标识，没有时返回空字符串
+func FromContext(ctx context.Context) string {
+	identity, _ := ctx.Value(identityContextKey{}).(string)
+	return identity
+}
+
+// AcquireFunc 为调用方获取一个工具调用并发名额，返回的函数用于释放名额
+type AcquireFunc func(ctx context.Context) (func(), error)
+
+// acquirerContextKey 并发名额获取函数在上下文中的键
+type acquirerContextKey struct{}
+
+// WithAcquirer 将调用方的并发名额获取函数放入上下文
+// batch_call 等在工具内部发起多个子调用的工具通过它为每个子调用单独获取名额
+func WithAcquirer(ctx context.Context, acquire AcquireFunc) context.Context {
+	return context.WithValue(ctx, acquirerContextKey{}, acquire)
+}
+
+// AcquirerFromContext 从上下文中获取并发名额获取函数，没有时返回 nil
+func AcquirerFromContext(ctx context.Context) AcquireFunc {
+	acquire, _ := ctx.Value(acquirerContextKey{}).(AcquireFunc)
+	return acquire
+}
+
+// Slot 调用方已获取的并发名额
+// 工具处理函数执行期间持有名额：处理函数超时后调用方提前返回，名额保留到处理函数真正返回后才释放，
+// 避免未响应取消的处理函数在名额释放后继续占用资源
+type Slot struct {
+	release  func()
+	holds    int
+	released bool
+	fired    bool
+	mutex    sync.Mutex
+}
+
+// Hold 推迟名额的释放，返回的函数解除推迟，在处理函数返回时调用
+func (s *Slot) Hold() func() {
+	s.mutex.Lock()
+	s.holds++
+	s.mutex.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			s.holds--
+			s.mutex.Unlock()
+			s.fire()
+		})
+	}
+}
+
+// Release 释放名额，仍有处理函数持有时在最后一个处理函数返回后释放
+func (s *Slot) Release() {
+	s.mutex.Lock()
+	s.released = true
+	s.mutex.Unlock()
+	s.fire()
+}
+
+// fire 调用方已释放且没有处理函数持有时释放名额，只生效一次
+func (s *Slot) fire() {
+	s.mutex.Lock()
+	ready := s.released && s.holds == 0 && !s.fired
+	if ready {
+		s.fired = true
+	}
+	s.mutex.Unlock()
+
+	if ready {
+		s.release()
+	}
+}
+
+// slotContextKey 并发名额在上下文中的键
+type slotContextKey struct{}
+
+// WithSlot 将调用方已获取的并发名额放入上下文，返回调用方结束时使用的释放函数
+// 工具注册表分发调用时持有上下文中的名额，直到处理函数返回
+func WithSlot(ctx context.Context, release func()) (context.Context, func()) {
+	slot := &Slot{release: release}
+	return context.WithValue(ctx, slotContextKey{}, slot), slot.Release
+}
+
+// SlotFromContext 从上下文中获取并发名额，没有时返回 nil
+func SlotFromContext(ctx context.Context) *Slot {
+	slot, _ := ctx.Value(slotContextKey{}).(*Slot)
+	return slot
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -42,6 +43,17 @@ type ServerConfig struct {
 	
 	// 优雅关闭超时时间
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	
+	// 是否启用gRPC服务 (MCPToolService)，与传输模式无关
+	GRPCEnabled bool `yaml:"grpc_enabled"`
+	
+	// gRPC服务监听端口，监听地址与HTTP服务相同
+	GRPCPort int `yaml:"grpc_port"`
+	
+	// gRPC服务的TLS证书和私钥文件 (PEM)，需同时配置；未配置时使用明文连接
+	// 启用鉴权且监听非回环地址时必须配置，避免凭证以明文传输
+	GRPCTLSCertFile string `yaml:"grpc_tls_cert_file"`
+	GRPCTLSKeyFile  string `yaml:"grpc_tls_key_file"`
 }
 
 // LoggingConfig 日志相关配置
@@ -55,6 +67,9 @@ type LoggingConfig struct {
 	// 日志输出文件路径，空则输出到stdout
 	File string `yaml:"file"`
 	
+	// 控制台输出目标 (stdout, stderr)，stdio模式下强制为stderr，避免污染协议输出
+	Output string `yaml:"output"`
+	
 	// 是否启用日志轮转
 	Rotate bool `yaml:"rotate"`
 	
@@ -79,7 +94,7 @@ type MCPConfig struct {
 	// 协议版本
 	ProtocolVersion string `yaml:"protocol_version"`
 	
-	// 传输模式 (stdio, sse, http)
+	// 传输模式 (stdio, sse, http, websocket)
 	Transport string `yaml:"transport"`
 	
 	// 支持的功能特性
@@ -91,6 +106,44 @@ type MCPConfig struct {
 	// 最大并发请求数
 	MaxConcurrentRequests int `yaml:"max_concurrent_requests"`
 	
+	// 单个客户端的最大并发请求数，0 表示只受全局限制
+	// 客户端按对端IP区分（stdio 模式按会话），经反向代理接入时所有请求的对端IP相同，共用一个上限
+	MaxConcurrentPerClient int `yaml:"max_concurrent_per_client"`
+	
+	// 等待队列长度，并发已满时超出队列的请求直接返回服务繁忙
+	MaxQueuedRequests int `yaml:"max_queued_requests"`
+	
+	// 请求在等待队列中的最长等待时间
+	QueueTimeout time.Duration `yaml:"queue_timeout"`
+	
+	// 会话空闲过期时间 (HTTP传输)，0 表示不过期
+	SessionTimeout time.Duration `yaml:"session_timeout"`
+	
+	// 每个会话保留的可补发事件数量 (SSE断线重连使用)
+	EventBufferSize int `yaml:"event_buffer_size"`
+	
+	// 会话总数上限 (HTTP、SSE 和 WebSocket 传输)，达到上限时拒绝创建新会话
+	MaxSessions int `yaml:"max_sessions"`
+	
+	// 单个客户端 (对端IP) 的会话数上限，0 表示只受总数限制
+	MaxSessionsPerClient int `yaml:"max_sessions_per_client"`
+	
+	// WebSocket 保活 ping 的发送间隔，超过两个间隔未收到任何帧时断开连接
+	WebSocketPingInterval time.Duration `yaml:"websocket_ping_interval"`
+	
+	// 允许建立 WebSocket 连接的来源 (Origin)，如 https://console.example.com，"*" 允许所有来源；
+	// 与服务器同主机的来源和不带 Origin 的非浏览器客户端始终允许
+	WebSocketAllowedOrigins []string `yaml:"websocket_allowed_origins"`
+	
+	// 被订阅资源的刷新间隔，0 表示不刷新
+	ResourceRefreshInterval time.Duration `yaml:"resource_refresh_interval"`
+	
+	// tools/list 每页返回的工具数量，0 表示不分页
+	ToolsPageSize int `yaml:"tools_page_size"`
+	
+	// 提示模板目录，目录下的 .yaml/.yml/.md 文件在内置提示模板之外加载，为空时只使用内置提示模板
+	PromptsDir string `yaml:"prompts_dir"`
+	
 	// 鉴权配置
 	Auth AuthConfig `yaml:"auth"`
 }
@@ -142,6 +195,9 @@ type ToolsConfig struct {
 	// 工具执行超时时间
 	ExecutionTimeout time.Duration `yaml:"execution_timeout"`
 	
+	// 按工具名称覆盖的执行超时时间
+	ToolTimeouts map[string]time.Duration `yaml:"tool_timeouts"`
+	
 	// 是否启用工具缓存
 	EnableCache bool `yaml:"enable_cache"`
 	
@@ -153,6 +209,24 @@ type ToolsConfig struct {
 	
 	// 禁用的工具列表
 	DisabledTools []string `yaml:"disabled_tools"`
+	
+	// 异步任务结束后的保留时间，过期后无法再查询结果
+	TaskRetention time.Duration `yaml:"task_retention"`
+	
+	// 异步任务的执行超时时间，替代工具执行超时
+	TaskTimeout time.Duration `yaml:"task_timeout"`
+	
+	// 同时运行的异步任务数，超出的任务排队等待
+	MaxRunningTasks int `yaml:"max_running_tasks"`
+	
+	// 单个客户端同时运行的异步任务数，不超过 MaxRunningTasks
+	MaxRunningTasksPerClient int `yaml:"max_running_tasks_per_client"`
+	
+	// 异步任务回调的 HMAC-SHA256 签名密钥，空则不允许回调
+	TaskCallbackSecret string `yaml:"task_callback_secret"`
+	
+	// 允许作为异步任务回调地址的主机，支持 *.example.com 形式的通配，空则不允许回调
+	TaskCallbackAllowedHosts []string `yaml:"task_callback_allowed_hosts"`
 }
 
 // LoadConfig 从环境变量和默认值加载配置
@@ -165,11 +239,16 @@ func LoadConfig() *Config {
 			WriteTimeout:    getEnvDuration("MCP_WRITE_TIMEOUT", 30*time.Second),
 			IdleTimeout:     getEnvDuration("MCP_IDLE_TIMEOUT", 60*time.Second),
 			ShutdownTimeout: getEnvDuration("MCP_SHUTDOWN_TIMEOUT", 10*time.Second),
+			GRPCEnabled:     getEnvBool("MCP_GRPC_ENABLED", false),
+			GRPCPort:        getEnvInt("MCP_GRPC_PORT", 9090),
+			GRPCTLSCertFile: getEnvString("MCP_GRPC_TLS_CERT_FILE", ""),
+			GRPCTLSKeyFile:  getEnvString("MCP_GRPC_TLS_KEY_FILE", ""),
 		},
 		Logging: LoggingConfig{
 			Level:      getEnvString("MCP_LOG_LEVEL", "info"),
 			Format:     getEnvString("MCP_LOG_FORMAT", "json"),
 			File:       getEnvString("MCP_LOG_FILE", ""),
+			Output:     getEnvString("MCP_LOG_OUTPUT", "stdout"),
 			Rotate:     getEnvBool("MCP_LOG_ROTATE", true),
 			MaxSize:    getEnvInt("MCP_LOG_MAX_SIZE", 100),
 			MaxBackups: getEnvInt("MCP_LOG_MAX_BACKUPS", 3),
@@ -183,11 +262,23 @@ func LoadConfig() *Config {
 			Capabilities: MCPCapabilities{
 				Tools:     getEnvBool("MCP_ENABLE_TOOLS", true),
 				Resources: getEnvBool("MCP_ENABLE_RESOURCES", false),
-				Prompts:   getEnvBool("MCP_ENABLE_PROMPTS", false),
+				Prompts:   getEnvBool("MCP_ENABLE_PROMPTS", true),
 				Logging:   getEnvBool("MCP_ENABLE_LOGGING", true),
 			},
-			RequestTimeout:        getEnvDuration("MCP_REQUEST_TIMEOUT", 60*time.Second),
-			MaxConcurrentRequests: getEnvInt("MCP_MAX_CONCURRENT_REQUESTS", 100),
+			RequestTimeout:          getEnvDuration("MCP_REQUEST_TIMEOUT", 60*time.Second),
+			MaxConcurrentRequests:   getEnvInt("MCP_MAX_CONCURRENT_REQUESTS", 100),
+			MaxConcurrentPerClient:  getEnvInt("MCP_MAX_CONCURRENT_PER_CLIENT", getEnvInt("MCP_MAX_CONCURRENT_PER_SESSION", 10)), // MCP_MAX_CONCURRENT_PER_SESSION 已废弃，仅为兼容保留
+			MaxQueuedRequests:       getEnvInt("MCP_MAX_QUEUED_REQUESTS", 200),
+			QueueTimeout:            getEnvDuration("MCP_QUEUE_TIMEOUT", 10*time.Second),
+			SessionTimeout:          getEnvDuration("MCP_SESSION_TIMEOUT", 30*time.Minute),
+			EventBufferSize:         getEnvInt("MCP_EVENT_BUFFER_SIZE", 100),
+			MaxSessions:             getEnvInt("MCP_MAX_SESSIONS", 1000),
+			MaxSessionsPerClient:    getEnvInt("MCP_MAX_SESSIONS_PER_CLIENT", 20),
+			WebSocketPingInterval:   getEnvDuration("MCP_WS_PING_INTERVAL", 30*time.Second),
+			WebSocketAllowedOrigins: getEnvStringSlice("MCP_WS_ALLOWED_ORIGINS", []string{}), // 默认只允许同主机来源
+			ResourceRefreshInterval: getEnvDuration("MCP_RESOURCE_REFRESH_INTERVAL", 60*time.Second),
+			ToolsPageSize:           getEnvInt("MCP_TOOLS_PAGE_SIZE", 50),
+			PromptsDir:              getEnvString("MCP_PROMPTS_DIR", ""),
 			Auth: AuthConfig{
 				Enabled:     getEnvBool("MCP_AUTH_ENABLED", false),
 				Type:        getEnvString("MCP_AUTH_TYPE", "bearer"),
@@ -200,11 +291,18 @@ func LoadConfig() *Config {
 			},
 		},
 		Tools: ToolsConfig{
-			ExecutionTimeout: getEnvDuration("MCP_TOOL_TIMEOUT", 30*time.Second),
-			EnableCache:      getEnvBool("MCP_TOOL_CACHE", false),
-			CacheExpiry:      getEnvDuration("MCP_TOOL_CACHE_EXPIRY", 5*time.Minute),
-			AllowedTools:     []string{}, // 默认允许所有工具
-			DisabledTools:    []string{}, // 默认不禁用任何工具
+			ExecutionTimeout:   getEnvDuration("MCP_TOOL_TIMEOUT", 30*time.Second),
+			ToolTimeouts:       getEnvDurationMap("MCP_TOOL_TIMEOUTS", map[string]time.Duration{}),
+			EnableCache:        getEnvBool("MCP_TOOL_CACHE", false),
+			CacheExpiry:        getEnvDuration("MCP_TOOL_CACHE_EXPIRY", 5*time.Minute),
+			AllowedTools:       getEnvStringSlice("MCP_ALLOWED_TOOLS", []string{}),  // 默认允许所有工具
+			DisabledTools:      getEnvStringSlice("MCP_DISABLED_TOOLS", []string{}), // 默认不禁用任何工具
+			TaskRetention:      getEnvDuration("MCP_TASK_RETENTION", time.Hour),
+			TaskTimeout:        getEnvDuration("MCP_TASK_TIMEOUT", 10*time.Minute),
+			MaxRunningTasks:    getEnvInt("MCP_MAX_RUNNING_TASKS", 10),
+			MaxRunningTasksPerClient: getEnvInt("MCP_MAX_RUNNING_TASKS_PER_CLIENT", 3),
+			TaskCallbackSecret: getEnvString("MCP_TASK_CALLBACK_SECRET", ""),
+			TaskCallbackAllowedHosts: getEnvStringSlice("MCP_TASK_CALLBACK_ALLOWED_HOSTS", []string{}), // 默认不允许回调
 		},
 	}
 }
@@ -215,6 +313,21 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
 	
+	if c.Server.GRPCEnabled {
+		if c.Server.GRPCPort <= 0 || c.Server.GRPCPort > 65535 {
+			return fmt.Errorf("invalid grpc port: %d", c.Server.GRPCPort)
+		}
+		if c.Server.GRPCPort == c.Server.Port && c.MCP.Transport != "stdio" {
+			return fmt.Errorf("grpc port %d conflicts with server port", c.Server.GRPCPort)
+		}
+		if (c.Server.GRPCTLSCertFile == "") != (c.Server.GRPCTLSKeyFile == "") {
+			return fmt.Errorf("grpc tls cert file and key file must be set together")
+		}
+		if c.MCP.Auth.Enabled && c.Server.GRPCTLSCertFile == "" && !isLoopbackHost(c.Server.Host) {
+			return fmt.Errorf("grpc tls is required when auth is enabled and listening on non-loopback host %q", c.Server.Host)
+		}
+	}
+	
 	if c.Server.ReadTimeout <= 0 {
 		return fmt.Errorf("read timeout must be positive")
 	}
@@ -227,12 +340,82 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("request timeout must be positive")
 	}
 	
+	if c.Tools.ExecutionTimeout <= 0 {
+		return fmt.Errorf("tool execution timeout must be positive")
+	}
+	
+	for toolName, timeout := range c.Tools.ToolTimeouts {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout for tool %s must be positive", toolName)
+		}
+	}
+	
+	if c.Tools.TaskRetention <= 0 {
+		return fmt.Errorf("task retention must be positive")
+	}
+	
+	if c.Tools.TaskTimeout <= 0 {
+		return fmt.Errorf("task timeout must be positive")
+	}
+	
+	if c.Tools.MaxRunningTasks <= 0 {
+		return fmt.Errorf("max running tasks must be positive")
+	}
+	
+	if c.Tools.MaxRunningTasksPerClient <= 0 {
+		return fmt.Errorf("max running tasks per client must be positive")
+	}
+	
+	if len(c.Tools.TaskCallbackAllowedHosts) > 0 && c.Tools.TaskCallbackSecret == "" {
+		return fmt.Errorf("task callback secret is required when task callback allowed hosts are configured")
+	}
+	
 	if c.MCP.MaxConcurrentRequests <= 0 {
 		return fmt.Errorf("max concurrent requests must be positive")
 	}
 	
+	if c.MCP.MaxConcurrentPerClient < 0 {
+		return fmt.Errorf("max concurrent requests per client must not be negative")
+	}
+	
+	if c.MCP.MaxQueuedRequests < 0 {
+		return fmt.Errorf("max queued requests must not be negative")
+	}
+	
+	if c.MCP.QueueTimeout <= 0 {
+		return fmt.Errorf("queue timeout must be positive")
+	}
+	
+	if c.MCP.SessionTimeout < 0 {
+		return fmt.Errorf("session timeout must not be negative")
+	}
+	
+	if c.MCP.EventBufferSize <= 0 {
+		return fmt.Errorf("event buffer size must be positive")
+	}
+	
+	if c.MCP.MaxSessions <= 0 {
+		return fmt.Errorf("max sessions must be positive")
+	}
+	
+	if c.MCP.MaxSessionsPerClient < 0 {
+		return fmt.Errorf("max sessions per client must not be negative")
+	}
+	
+	if c.MCP.WebSocketPingInterval <= 0 {
+		return fmt.Errorf("websocket ping interval must be positive")
+	}
+	
+	if c.MCP.ToolsPageSize < 0 {
+		return fmt.Errorf("tools page size must not be negative")
+	}
+	
+	if c.MCP.ResourceRefreshInterval < 0 {
+		return fmt.Errorf("resource refresh interval must not be negative")
+	}
+	
 	// 验证传输模式
-	validTransports := []string{"stdio", "sse", "http"}
+	validTransports := []string{"stdio", "sse", "http", "websocket"}
 	if !contains(validTransports, c.MCP.Transport) {
 		return fmt.Errorf("invalid transport mode: %s, valid options: %v", c.MCP.Transport, validTransports)
 	}
@@ -244,8 +427,8 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("invalid auth type: %s, valid options: %v", c.MCP.Auth.Type, validAuthTypes)
 		}
 		
-		// 对于HTTP传输，如果启用了鉴权，必须提供相应的凭据
-		if c.MCP.Transport == "http" || c.MCP.Transport == "sse" {
+		// 对于HTTP传输和gRPC服务，如果启用了鉴权，必须提供相应的凭据
+		if c.MCP.Transport != "stdio" || c.Server.GRPCEnabled {
 			switch c.MCP.Auth.Type {
 			case "bearer":
 				if c.MCP.Auth.BearerToken == "" {
@@ -309,6 +492,26 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// 辅助函数：从环境变量获取名称到时间间隔的映射，格式为 name=30s,name2=2m
+func getEnvDurationMap(key string, defaultValue map[string]time.Duration) map[string]time.Duration {
+	if value := os.Getenv(key); value != "" {
+		result := make(map[string]time.Duration)
+		for _, part := range splitAndTrim(value, ",") {
+			pair := splitAndTrim(part, "=")
+			if len(pair) != 2 || pair[0] == "" {
+				continue
+			}
+			if duration, err := time.ParseDuration(pair[1]); err == nil {
+				result[pair[0]] = duration
+			}
+		}
+		if len(result) > 0 {
+			return result
+		}
+	}
+	return defaultValue
+}
+
 // 辅助函数：从环境变量获取字符串切片值
 func getEnvStringSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
@@ -336,6 +539,15 @@ func splitAndTrim(s, sep string) []string {
 	return parts
 }
 
+// isLoopbackHost 判断监听地址是否只接受本机连接
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // 辅助函数：检查切片是否包含指定元素
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -0,0 +1,259 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// requestTimeout 单次 Kubernetes API 请求的超时时间
+const requestTimeout = 30 * time.Second
+
+// kubeconfig kubeconfig 文件中用到的字段
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// Client 基于 kubeconfig 访问 kube-apiserver 的只读客户端
+type Client struct {
+	server     string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClientFromKubeconfig 根据 kubeconfig 内容创建客户端，使用 current-context 指定的集群和用户
+func NewClientFromKubeconfig(data []byte) (*Client, error) {
+	var config kubeconfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("解析 kubeconfig 失败: %w", err)
+	}
+
+	clusterName, userName := "", ""
+	for _, c := range config.Contexts {
+		if c.Name == config.CurrentContext || (config.CurrentContext == "" && len(config.Contexts) == 1) {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+
+	client := &Client{}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		client.server = strings.TrimSuffix(c.Cluster.Server, "/")
+		tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+		if c.Cluster.CertificateAuthorityData != "" {
+			ca, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+			if err != nil {
+				return nil, fmt.Errorf("解析集群 CA 证书失败: %w", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("集群 CA 证书无效")
+			}
+			tlsConfig.RootCAs = pool
+		}
+	}
+	if client.server == "" {
+		return nil, fmt.Errorf("kubeconfig 中没有找到集群 %q 的访问地址", clusterName)
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		client.token = u.User.Token
+		if u.User.ClientCertificateData != "" && u.User.ClientKeyData != "" {
+			cert, err := base64.StdEncoding.DecodeString(u.User.ClientCertificateData)
+			if err != nil {
+				return nil, fmt.Errorf("解析客户端证书失败: %w", err)
+			}
+			key, err := base64.StdEncoding.DecodeString(u.User.ClientKeyData)
+			if err != nil {
+				return nil, fmt.Errorf("解析客户端私钥失败: %w", err)
+			}
+			pair, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, fmt.Errorf("加载客户端证书失败: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+	}
+
+	client.httpClient = &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		},
+	}
+	return client, nil
+}
+
+// PodInfo Pod 信息
+type PodInfo struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Phase     string            `json:"phase"`
+	Ready     string            `json:"ready"`
+	Restarts  int32             `json:"restarts"`
+	PodIP     string            `json:"pod_ip"`
+	HostIP    string            `json:"host_ip"`
+	NodeName  string            `json:"node_name"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	CreatedAt string            `json:"created_at"`
+}
+
+// PodListInfo 命名空间下的 Pod 列表
+type PodListInfo struct {
+	Namespace string    `json:"namespace"`
+	PodCount  int       `json:"pod_count"`
+	Pods      []PodInfo `json:"pods"`
+}
+
+// podList kube-apiserver 返回的 PodList 中用到的字段
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			Name              string            `json:"name"`
+			Namespace         string            `json:"namespace"`
+			Labels            map[string]string `json:"labels"`
+			CreationTimestamp string            `json:"creationTimestamp"`
+		} `json:"metadata"`
+		Spec struct {
+			NodeName string `json:"nodeName"`
+		} `json:"spec"`
+		Status struct {
+			Phase             string `json:"phase"`
+			PodIP             string `json:"podIP"`
+			HostIP            string `json:"hostIP"`
+			ContainerStatuses []struct {
+				Ready        bool  `json:"ready"`
+				RestartCount int32 `json:"restartCount"`
+			} `json:"containerStatuses"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// ListPods 查询命名空间下的 Pod 列表
+func (c *Client) ListPods(ctx context.Context, namespace string) (*PodListInfo, error) {
+	var list podList
+	if err := c.get(ctx, "/api/v1/namespaces/"+url.PathEscape(namespace)+"/pods", &list); err != nil {
+		return nil, err
+	}
+
+	info := &PodListInfo{
+		Namespace: namespace,
+		Pods:      make([]PodInfo, 0, len(list.Items)),
+	}
+	for _, item := range list.Items {
+		pod := PodInfo{
+			Name:      item.Metadata.Name,
+			Namespace: item.Metadata.Namespace,
+			Phase:     item.Status.Phase,
+			PodIP:     item.Status.PodIP,
+			HostIP:    item.Status.HostIP,
+			NodeName:  item.Spec.NodeName,
+			Labels:    item.Metadata.Labels,
+			CreatedAt: item.Metadata.CreationTimestamp,
+		}
+		ready := 0
+		for _, status := range item.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+			pod.Restarts += status.RestartCount
+		}
+		pod.Ready = fmt.Sprintf("%d/%d", ready, len(item.Status.ContainerStatuses))
+		info.Pods = append(info.Pods, pod)
+	}
+	info.PodCount = len(info.Pods)
+
+	return info, nil
+}
+
+// get 请求 kube-apiserver 并解析 JSON 响应
+func (c *Client) get(ctx context.Context, path string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.server+path, nil)
+	if err != nil {
+		return fmt.Errorf("创建 Kubernetes API 请求失败: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 Kubernetes API 失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("读取 Kubernetes API 响应失败: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// 错误响应为 Status 对象，优先使用其中的 message
+		var status struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &status) == nil && status.Message != "" {
+			return &APIError{StatusCode: resp.StatusCode, Message: status.Message}
+		}
+		return &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("解析 Kubernetes API 响应失败: %w", err)
+	}
+	return nil
+}
+
+// APIError kube-apiserver 返回的错误
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error 实现 error 接口
+func (e *APIError) Error() string {
+	return fmt.Sprintf("Kubernetes API 错误 [%d]: %s", e.StatusCode, e.Message)
+}
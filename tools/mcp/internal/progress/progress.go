@@ -0,0 +1,31 @@
+package progress
+
+import (
+	"context"
+)
+
+// Reporter 进度上报函数，total 未知时为 0
+type Reporter func(progress, total float64, message string)
+
+// reporterContextKey 进度上报函数在上下文中的键
+type reporterContextKey struct{}
+
+// WithReporter 将进度上报函数放入上下文
+// 上下文中已有上报函数时，新的上报函数负责继续向外层传递
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterContextKey{}, reporter)
+}
+
+// FromContext 从上下文中获取进度上报函数，没有时返回 nil
+func FromContext(ctx context.Context) Reporter {
+	reporter, _ := ctx.Value(reporterContextKey{}).(Reporter)
+	return reporter
+}
+
+// Report 通过上下文中的上报函数上报进度，没有上报函数时忽略
+// 腾讯云客户端等不依赖工具包的代码也可以据此上报分页、地域等进度
+func Report(ctx context.Context, progress, total float64, message string) {
+	if reporter := FromContext(ctx); reporter != nil {
+		reporter(progress, total, message)
+	}
+}
@@ -0,0 +1,85 @@
+package prompts
+
+// regionArgument 地域参数，所有内置提示模板共用
+var regionArgument = Argument{
+	Name:        "region",
+	Description: "地域ID(如ap-beijing、ap-guangzhou等)",
+	Required:    true,
+}
+
+// builtinPrompts 内置的 SRE 排障提示模板
+// 消息中写明排查步骤及对应的工具名称，引导模型按顺序调用工具收集信息
+func builtinPrompts() []*Prompt {
+	return []*Prompt{
+		{
+			Name:        "diagnose_tke_cluster",
+			Title:       "诊断 TKE 集群",
+			Description: "诊断 TKE 集群的健康状况，检查集群状态、节点、master 组件和日志采集配置",
+			Arguments: []Argument{
+				regionArgument,
+				{Name: "cluster_id", Description: "TKE 集群ID", Required: true},
+				{Name: "symptom", Description: "观察到的异常现象(可选)，如 Pod 无法调度、apiserver 超时", Default: "未提供"},
+			},
+			Messages: []Message{
+				{
+					Role: "user",
+					Content: `请诊断地域 {region} 中 TKE 集群 {cluster_id} 的健康状况。已知现象：{symptom}
+
+请按以下步骤使用工具收集信息：
+1. 调用 tke_describe_clusters（region={region}）确认集群状态、版本和节点数量
+2. 调用 tke_describe_cluster_instances（region={region}, cluster_id={cluster_id}, instance_role=ALL）检查节点状态，关注非 running 或异常失败的节点
+3. 调用 tke_describe_master_component（region={region}, cluster_id={cluster_id}）分别检查 kube-apiserver、kube-scheduler、kube-controller-manager 的运行状态
+4. 调用 tke_describe_addon（region={region}, cluster_id={cluster_id}）检查集群组件是否安装成功
+5. 调用 tke_describe_log_switches（region={region}, cluster_id={cluster_id}）确认审计日志和事件日志是否开启，便于后续排查
+
+最后给出结论：集群是否健康、发现的问题、可能的原因以及建议的处理措施。`,
+				},
+			},
+		},
+		{
+			Name:        "investigate_cdb_slow_queries",
+			Title:       "排查 CDB 慢查询",
+			Description: "排查 CDB (MySQL) 实例的慢查询，结合实例规格和错误日志分析原因",
+			Arguments: []Argument{
+				regionArgument,
+				{Name: "instance_id", Description: "CDB 实例ID", Required: true},
+			},
+			Messages: []Message{
+				{
+					Role: "user",
+					Content: `请排查地域 {region} 中 CDB (MySQL) 实例 {instance_id} 的慢查询问题。
+
+请按以下步骤使用工具收集信息：
+1. 调用 cdb_describe_db_instance_info（region={region}, instance_id={instance_id}）确认实例规格、版本和状态
+2. 调用 cdb_describe_slow_logs（region={region}, instance_id={instance_id}）查看慢查询日志文件的时间分布和大小，判断慢查询集中出现的时段
+3. 调用 cdb_describe_error_log（region={region}, instance_id={instance_id}）检查同一时段的错误日志，关注锁等待、连接数过多、主从切换等信息
+
+最后总结：慢查询是否集中在特定时段、与错误日志是否相关、实例规格是否存在瓶颈，并给出优化建议（索引、SQL 改写、规格调整等）。`,
+				},
+			},
+		},
+		{
+			Name:        "explain_clb_unhealthy_targets",
+			Title:       "分析 CLB 后端异常",
+			Description: "分析 CLB 负载均衡后端服务健康检查失败的原因",
+			Arguments: []Argument{
+				regionArgument,
+				{Name: "load_balancer_id", Description: "CLB 实例ID", Required: true},
+			},
+			Messages: []Message{
+				{
+					Role: "user",
+					Content: `请分析地域 {region} 中 CLB 实例 {load_balancer_id} 的后端服务为什么健康检查失败。
+
+请按以下步骤使用工具收集信息：
+1. 调用 clb_describe_target_health（region={region}, load_balancer_ids={load_balancer_id}）找出健康检查失败的后端及其监听器、端口
+2. 调用 clb_describe_listeners（region={region}, load_balancer_id={load_balancer_id}）查看对应监听器的协议、端口和健康检查配置
+3. 调用 clb_describe_targets（region={region}, load_balancer_id={load_balancer_id}）确认后端实例、端口和权重
+4. 如后端为 CVM，调用 cvm_describe_instances_status（region={region}）确认实例是否运行中；必要时调用 vpc_describe_security_groups（region={region}）检查安全组是否放通健康检查流量
+
+最后解释健康检查失败的可能原因（实例未运行、端口未监听、安全组拦截、健康检查路径或超时配置不当等），并给出修复建议。`,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package prompts
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// Library 提示模板库
+// 包含内置提示模板，以及从目录加载的提示模板；同名时后注册的覆盖先注册的
+type Library struct {
+	prompts map[string]*Prompt
+	order   []string
+	mutex   sync.RWMutex
+}
+
+// NewLibrary 创建包含内置提示模板的提示模板库
+func NewLibrary() *Library {
+	library := &Library{
+		prompts: make(map[string]*Prompt),
+	}
+	for _, prompt := range builtinPrompts() {
+		if err := library.Register(prompt); err != nil {
+			logger.WithFields(logrus.Fields{
+				"prompt": prompt.Name,
+				"error":  err.Error(),
+			}).Error("Failed to register builtin prompt")
+		}
+	}
+	return library
+}
+
+// Register 注册提示模板
+func (l *Library) Register(prompt *Prompt) error {
+	if err := prompt.validate(); err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, exists := l.prompts[prompt.Name]; !exists {
+		l.order = append(l.order, prompt.Name)
+	} else {
+		logger.WithFields(logrus.Fields{
+			"prompt": prompt.Name,
+		}).Info("Prompt overridden")
+	}
+	l.prompts[prompt.Name] = prompt
+
+	logger.WithFields(logrus.Fields{
+		"prompt":    prompt.Name,
+		"arguments": len(prompt.Arguments),
+	}).Debug("Registered prompt")
+	return nil
+}
+
+// ListPrompts 列出提示模板，返回 prompts/list 中的描述
+func (l *Library) ListPrompts() []map[string]interface{} {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	prompts := make([]map[string]interface{}, 0, len(l.order))
+	for _, name := range l.order {
+		prompts = append(prompts, l.prompts[name].Info())
+	}
+	return prompts
+}
+
+// GetPrompt 渲染提示模板，返回 prompts/get 的结果
+// 提示模板不存在时返回 nil；缺少必填参数时返回错误
+func (l *Library) GetPrompt(name string, arguments map[string]string) (map[string]interface{}, error) {
+	l.mutex.RLock()
+	prompt, exists := l.prompts[name]
+	l.mutex.RUnlock()
+	if !exists {
+		return nil, nil
+	}
+
+	messages, err := prompt.Render(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, 0, len(messages))
+	for _, message := range messages {
+		result = append(result, map[string]interface{}{
+			"role": message.Role,
+			"content": map[string]interface{}{
+				"type": "text",
+				"text": message.Content,
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"description": prompt.Description,
+		"messages":    result,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package prompts
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// LoadDir 从目录加载提示模板，返回加载的数量
+// 支持两种文件格式（不递归子目录，文件名即默认的提示模板名称）：
+//   - .yaml / .yml: 完整的提示模板定义
+//   - .md: 以 --- 包围的 YAML 头部定义名称、描述和参数，正文作为一条 user 消息
+func (l *Library) LoadDir(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read prompts directory %s: %w", dir, err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	loaded := 0
+	for _, name := range names {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".yaml" && ext != ".yml" && ext != ".md" {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return loaded, fmt.Errorf("failed to read prompt file %s: %w", path, err)
+		}
+
+		var prompt *Prompt
+		if ext == ".md" {
+			prompt, err = parseMarkdown(data)
+		} else {
+			prompt, err = parseYAML(data)
+		}
+		if err != nil {
+			return loaded, fmt.Errorf("failed to parse prompt file %s: %w", path, err)
+		}
+		if prompt.Name == "" {
+			prompt.Name = strings.TrimSuffix(name, filepath.Ext(name))
+		}
+
+		if err := l.Register(prompt); err != nil {
+			return loaded, fmt.Errorf("invalid prompt file %s: %w", path, err)
+		}
+		loaded++
+	}
+
+	logger.WithFields(logrus.Fields{
+		"dir":    dir,
+		"loaded": loaded,
+	}).Info("Loaded prompts from directory")
+	return loaded, nil
+}
+
+// parseYAML 解析 YAML 格式的提示模板
+func parseYAML(data []byte) (*Prompt, error) {
+	var prompt Prompt
+	if err := yaml.Unmarshal(data, &prompt); err != nil {
+		return nil, err
+	}
+	return &prompt, nil
+}
+
+// parseMarkdown 解析 Markdown 格式的提示模板
+func parseMarkdown(data []byte) (*Prompt, error) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	var prompt Prompt
+	body := string(data)
+	if strings.HasPrefix(body, "---\n") {
+		end := strings.Index(body[4:], "\n---")
+		if end < 0 {
+			return nil, fmt.Errorf("front matter is not closed")
+		}
+		if err := yaml.Unmarshal([]byte(body[4:4+end]), &prompt); err != nil {
+			return nil, fmt.Errorf("invalid front matter: %w", err)
+		}
+		body = body[4+end+len("\n---"):]
+	}
+
+	body = strings.TrimSpace(body)
+	if body != "" {
+		prompt.Messages = append(prompt.Messages, Message{Role: "user", Content: body})
+	}
+	return &prompt, nil
+}
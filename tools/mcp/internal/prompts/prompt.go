@@ -0,0 +1,123 @@
+package prompts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Argument 提示模板参数
+type Argument struct {
+	// 参数名称，消息内容中以 {参数名} 引用
+	Name string `yaml:"name"`
+
+	// 参数描述
+	Description string `yaml:"description"`
+
+	// 是否必填
+	Required bool `yaml:"required"`
+
+	// 未传入时使用的默认值
+	Default string `yaml:"default"`
+}
+
+// Message 提示模板消息
+type Message struct {
+	// 消息角色: user 或 assistant
+	Role string `yaml:"role"`
+
+	// 消息内容，{参数名} 在渲染时替换为参数值
+	Content string `yaml:"content"`
+}
+
+// Prompt 提示模板
+type Prompt struct {
+	// 提示模板名称，prompts/get 通过名称获取
+	Name string `yaml:"name"`
+
+	// 显示名称
+	Title string `yaml:"title"`
+
+	// 提示模板描述
+	Description string `yaml:"description"`
+
+	// 参数列表
+	Arguments []Argument `yaml:"arguments"`
+
+	// 消息列表
+	Messages []Message `yaml:"messages"`
+}
+
+// validate 校验提示模板定义
+func (p *Prompt) validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("prompt name is required")
+	}
+	if len(p.Messages) == 0 {
+		return fmt.Errorf("prompt %s has no messages", p.Name)
+	}
+	for _, message := range p.Messages {
+		if message.Role != "user" && message.Role != "assistant" {
+			return fmt.Errorf("prompt %s has invalid message role %q", p.Name, message.Role)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.Arguments))
+	for _, argument := range p.Arguments {
+		if argument.Name == "" {
+			return fmt.Errorf("prompt %s has an argument without name", p.Name)
+		}
+		if seen[argument.Name] {
+			return fmt.Errorf("prompt %s has duplicate argument %s", p.Name, argument.Name)
+		}
+		seen[argument.Name] = true
+	}
+	return nil
+}
+
+// Info 返回 prompts/list 中的提示模板描述
+func (p *Prompt) Info() map[string]interface{} {
+	arguments := make([]map[string]interface{}, 0, len(p.Arguments))
+	for _, argument := range p.Arguments {
+		arguments = append(arguments, map[string]interface{}{
+			"name":        argument.Name,
+			"description": argument.Description,
+			"required":    argument.Required,
+		})
+	}
+
+	info := map[string]interface{}{
+		"name":        p.Name,
+		"description": p.Description,
+		"arguments":   arguments,
+	}
+	if p.Title != "" {
+		info["title"] = p.Title
+	}
+	return info
+}
+
+// Render 使用参数渲染消息
+// 只替换已声明参数的 {参数名} 占位符，其他花括号内容原样保留；缺少必填参数时返回错误
+func (p *Prompt) Render(arguments map[string]string) ([]Message, error) {
+	pairs := make([]string, 0, len(p.Arguments)*2)
+	for _, argument := range p.Arguments {
+		value, ok := arguments[argument.Name]
+		if !ok || value == "" {
+			if argument.Required {
+				return nil, fmt.Errorf("missing required argument: %s", argument.Name)
+			}
+			value = argument.Default
+		}
+		pairs = append(pairs, "{"+argument.Name+"}", value)
+	}
+	replacer := strings.NewReplacer(pairs...)
+
+	messages := make([]Message, 0, len(p.Messages))
+	for _, message := range p.Messages {
+		messages = append(messages, Message{
+			Role:    message.Role,
+			Content: replacer.Replace(message.Content),
+		})
+	}
+	return messages, nil
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package server
+
+import "time"
+
+// processCPUTime 当前平台不统计 CPU 时间
+func processCPUTime() time.Duration {
+	return 0
+}
@@ -0,0 +1,685 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"runtime"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"ai-sre/tools/mcp/internal/auth"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/config"
+	"ai-sre/tools/mcp/internal/progress"
+	"ai-sre/tools/mcp/internal/tools"
+	"ai-sre/tools/mcp/internal/transport"
+	"ai-sre/tools/mcp/pkg/logger"
+	mcpv1 "ai-sre/tools/mcp/pkg/generated/proto/mcp"
+)
+
+// defaultGRPCPageSize ListTools 未指定 limit 时每页返回的工具数量
+const defaultGRPCPageSize = 50
+
+// grpcCategories 工具分类对应的 proto 工具分类
+// 工具可属于多个分类，Tool.category 取第一个分类对应的值，ListTools 按分类过滤时匹配任一分类
+var grpcCategories = map[string]mcpv1.ToolCategory{
+	tools.CategoryTKE:         mcpv1.ToolCategory_TOOL_CATEGORY_CONTAINER,
+	tools.CategoryCVM:         mcpv1.ToolCategory_TOOL_CATEGORY_CLOUD,
+	tools.CategoryCLB:         mcpv1.ToolCategory_TOOL_CATEGORY_NETWORK,
+	tools.CategoryCDB:         mcpv1.ToolCategory_TOOL_CATEGORY_DATABASE,
+	tools.CategoryVPC:         mcpv1.ToolCategory_TOOL_CATEGORY_NETWORK,
+	tools.CategoryK8s:         mcpv1.ToolCategory_TOOL_CATEGORY_CONTAINER,
+	tools.CategoryDiagnostics: mcpv1.ToolCategory_TOOL_CATEGORY_MONITORING,
+}
+
+// grpcToolService MCPToolService 的实现
+// 与 MCP JSON-RPC 共用全局工具注册表、请求超时和工具调用并发限制器
+type grpcToolService struct {
+	mcpv1.UnimplementedMCPToolServiceServer
+
+	config    *config.Config
+	registry  *tools.GlobalToolRegistry
+	limiter   *transport.ConcurrencyLimiter // nil 表示不限制
+	startTime time.Time
+}
+
+// newGRPCServer 创建注册了 MCPToolService 的 gRPC 服务器，启用鉴权时使用与 HTTP 端点相同的鉴权配置
+func newGRPCServer(cfg *config.Config, limiter *transport.ConcurrencyLimiter, authMiddleware *auth.AuthMiddleware) (*grpc.Server, error) {
+	var options []grpc.ServerOption
+	if cfg.Server.GRPCTLSCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.Server.GRPCTLSCertFile, cfg.Server.GRPCTLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC TLS certificate: %w", err)
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+	if authMiddleware != nil {
+		options = append(options,
+			grpc.ChainUnaryInterceptor(authMiddleware.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authMiddleware.StreamServerInterceptor()),
+		)
+	}
+
+	grpcServer := grpc.NewServer(options...)
+	mcpv1.RegisterMCPToolServiceServer(grpcServer, &grpcToolService{
+		config:    cfg,
+		registry:  tools.GetGlobalRegistry(),
+		limiter:   limiter,
+		startTime: time.Now(),
+	})
+	return grpcServer, nil
+}
+
+// ListTools 列出可用的工具，支持按分类、标签过滤和分页
+// 标签即工具分类（tke、cvm、k8s 等），指定多个标签时工具需同时具备
+func (s *grpcToolService) ListTools(ctx context.Context, req *mcpv1.ListToolsRequest) (*mcpv1.ListToolsResponse, error) {
+	matched := make([]*tools.ToolDefinition, 0)
+	for _, def := range s.registry.ListDefinitions() {
+		if !s.registry.IsToolEnabled(def.Name) {
+			continue
+		}
+		if req.GetCategory() != mcpv1.ToolCategory_TOOL_CATEGORY_UNSPECIFIED && !hasGRPCCategory(def, req.GetCategory()) {
+			continue
+		}
+		if !hasAllCategories(def, req.GetTags()) {
+			continue
+		}
+		matched = append(matched, def)
+	}
+
+	page := int(req.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultGRPCPageSize
+	}
+
+	total := len(matched)
+	pages := (total + limit - 1) / limit
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	toolList := make([]*mcpv1.Tool, 0, end-start)
+	for _, def := range matched[start:end] {
+		tool, err := s.toProtoTool(def)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert tool %s: %v", def.Name, err)
+		}
+		toolList = append(toolList, tool)
+	}
+
+	return &mcpv1.ListToolsResponse{
+		Tools: toolList,
+		Pagination: &mcpv1.PaginationInfo{
+			Page:    int32(page),
+			Limit:   int32(limit),
+			Total:   int64(total),
+			Pages:   int32(pages),
+			HasNext: page < pages,
+			HasPrev: page > 1,
+		},
+	}, nil
+}
+
+// GetTool 获取单个工具的描述，工具不存在或已禁用时返回 NotFound
+func (s *grpcToolService) GetTool(ctx context.Context, req *mcpv1.GetToolRequest) (*mcpv1.Tool, error) {
+	def, err := s.lookupTool(req.GetToolName())
+	if err != nil {
+		return nil, err
+	}
+
+	tool, err := s.toProtoTool(def)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert tool %s: %v", def.Name, err)
+	}
+	return tool, nil
+}
+
+// CallTool 调用工具
+// 工具执行失败（含执行超时）以 success=false 返回，error_code 为错误分类；gRPC 错误只用于工具不存在、服务繁忙等调用层面的问题。
+// options.async 为 true 时提交异步任务，result 为任务信息，通过 get_task_result 工具或 REST 任务接口查询结果
+func (s *grpcToolService) CallTool(ctx context.Context, req *mcpv1.CallToolRequest) (*mcpv1.CallToolResponse, error) {
+	if _, err := s.lookupTool(req.GetToolName()); err != nil {
+		return nil, err
+	}
+	if req.GetOptions().GetAsync() {
+		return s.submitTask(ctx, req)
+	}
+
+	ctx, release, err := s.acquire(ctx, req.GetToolName())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.invoke(ctx, req.GetToolName(), req.GetArguments(), req.GetOptions().GetTimeoutSeconds())
+}
+
+// submitTask 以客户端IP作为任务所有者提交异步工具调用
+// 回调地址无效返回 InvalidArgument，任务数量达到上限返回 ResourceExhausted
+func (s *grpcToolService) submitTask(ctx context.Context, req *mcpv1.CallToolRequest) (*mcpv1.CallToolResponse, error) {
+	task, err := tools.GetTaskManager().Submit(peerHost(ctx), req.GetToolName(), req.GetArguments().AsMap(), req.GetOptions().GetCallbackUrl())
+	var toolErr *tools.ToolError
+	if errors.As(err, &toolErr) {
+		switch toolErr.ErrorCategory() {
+		case tools.ErrorCategoryNotFound:
+			return nil, status.Error(codes.NotFound, toolErr.Error())
+		case tools.ErrorCategoryInvalidArgument:
+			return nil, status.Error(codes.InvalidArgument, toolErr.Error())
+		default:
+			return nil, status.Error(codes.ResourceExhausted, toolErr.Error())
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to submit task: %v", err)
+	}
+
+	result, err := toStruct(task)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode task: %v", err)
+	}
+	return &mcpv1.CallToolResponse{
+		Success:   true,
+		Result:    result,
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
+
+// BatchCallTools 按限定的并发数批量调用工具，结果与请求中的调用顺序一致
+// 每个调用单独占用一个并发名额，整个批量调用受请求超时限制，单个调用的 options.timeout_seconds 作为该调用的超时时间
+func (s *grpcToolService) BatchCallTools(ctx context.Context, req *mcpv1.BatchCallToolsRequest) (*mcpv1.BatchCallToolsResponse, error) {
+	calls := make([]tools.BatchCall, 0, len(req.GetCalls()))
+	for _, call := range req.GetCalls() {
+		if call.GetOptions().GetAsync() {
+			return nil, status.Error(codes.InvalidArgument, "async is not supported in batch calls")
+		}
+		calls = append(calls, tools.BatchCall{
+			ToolName:  call.GetToolName(),
+			Arguments: call.GetArguments().AsMap(),
+			Timeout:   time.Duration(call.GetOptions().GetTimeoutSeconds()) * time.Second,
+		})
+	}
+
+	ctx, release, err := s.acquire(ctx, "batch_call")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if timeout := s.config.MCP.RequestTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	result, err := s.registry.BatchCall(ctx, calls, tools.BatchOptions{
+		MaxConcurrency: int(req.GetMaxConcurrency()),
+		FailFast:       req.GetFailFast(),
+		Acquire:        caller.AcquirerFromContext(ctx),
+	})
+	var toolErr *tools.ToolError
+	if errors.As(err, &toolErr) {
+		return nil, status.Error(codes.InvalidArgument, toolErr.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "batch call failed: %v", err)
+	}
+
+	response := &mcpv1.BatchCallToolsResponse{
+		Results:      make([]*mcpv1.CallToolResponse, 0, len(result.Results)),
+		SuccessCount: int32(result.SuccessCount),
+		FailureCount: int32(result.FailureCount),
+	}
+	now := timestamppb.Now()
+	for _, item := range result.Results {
+		callResponse := &mcpv1.CallToolResponse{
+			Success:      item.Success,
+			ErrorMessage: item.ErrorMessage,
+			ErrorCode:    item.ErrorCode,
+			Metrics:      &mcpv1.ToolExecutionMetrics{ExecutionTime: item.ExecutionTimeMs},
+			Timestamp:    now,
+		}
+		if item.Success {
+			callResponse.Result, err = toStruct(item.Result)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to encode result of tool %s: %v", item.Name, err)
+			}
+		} else if item.ErrorDetails != nil {
+			callResponse.Result, _ = toStruct(item.ErrorDetails)
+		}
+		response.Results = append(response.Results, callResponse)
+	}
+	return response, nil
+}
+
+// HealthCheck 返回服务健康状态，包含工具注册表和并发限制器两个组件
+func (s *grpcToolService) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*mcpv1.HealthCheckResponse, error) {
+	now := timestamppb.Now()
+	enabled := 0
+	for _, name := range s.registry.ListTools() {
+		if s.registry.IsToolEnabled(name) {
+			enabled++
+		}
+	}
+
+	components := map[string]*mcpv1.ComponentHealth{
+		"tool_registry": {
+			Status:    mcpv1.HealthStatus_HEALTH_STATUS_HEALTHY,
+			Message:   fmt.Sprintf("%d tools enabled", enabled),
+			LastCheck: now,
+		},
+	}
+	if enabled == 0 {
+		components["tool_registry"].Status = mcpv1.HealthStatus_HEALTH_STATUS_UNHEALTHY
+	}
+
+	// 有请求排队说明并发已满，视为降级
+	if s.limiter != nil {
+		stats := s.limiter.Stats()
+		concurrency := &mcpv1.ComponentHealth{
+			Status:    mcpv1.HealthStatus_HEALTH_STATUS_HEALTHY,
+			Message:   fmt.Sprintf("active=%d, queued=%d", stats.Active, stats.Queued),
+			LastCheck: now,
+		}
+		if stats.Queued > 0 {
+			concurrency.Status = mcpv1.HealthStatus_HEALTH_STATUS_DEGRADED
+		}
+		components["concurrency"] = concurrency
+	}
+
+	overall := mcpv1.HealthStatus_HEALTH_STATUS_HEALTHY
+	for _, component := range components {
+		if component.Status > overall {
+			overall = component.Status
+		}
+	}
+
+	return &mcpv1.HealthCheckResponse{
+		Status:     overall,
+		Service:    s.config.MCP.Name,
+		Version:    s.config.MCP.Version,
+		Uptime:     int64(time.Since(s.startTime).Seconds()),
+		Components: components,
+	}, nil
+}
+
+// StreamToolCall 以事件流的形式调用工具
+// 依次推送 STARTED、工具上报的 PROGRESS、工具调用期间的 LOG 事件，最后以 COMPLETED 或 FAILED 结束
+func (s *grpcToolService) StreamToolCall(req *mcpv1.StreamToolCallRequest, stream grpc.ServerStreamingServer[mcpv1.ToolCallEvent]) error {
+	ctx := stream.Context()
+	toolName := req.GetToolName()
+	if _, err := s.lookupTool(toolName); err != nil {
+		return err
+	}
+
+	ctx, release, err := s.acquire(ctx, toolName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := stream.Send(newToolCallEvent(mcpv1.ToolCallEventType_TOOL_CALL_EVENT_TYPE_STARTED, nil, "tool call started")); err != nil {
+		return err
+	}
+
+	// 进度和日志在工具的 goroutine 中产生，统一经由 events 在当前 goroutine 发送，stream.Send 不支持并发调用
+	events := make(chan *mcpv1.ToolCallEvent, 64)
+	finished := make(chan struct{})
+	defer close(finished)
+	push := func(event *mcpv1.ToolCallEvent) {
+		select {
+		case events <- event:
+		case <-finished:
+		}
+	}
+
+	callCtx := progress.WithReporter(ctx, func(current, total float64, message string) {
+		data, _ := structpb.NewStruct(map[string]interface{}{
+			"progress": current,
+			"total":    total,
+		})
+		push(newToolCallEvent(mcpv1.ToolCallEventType_TOOL_CALL_EVENT_TYPE_PROGRESS, data, message))
+	})
+	callCtx = logger.WithSessionLog(callCtx, func(entry *logrus.Entry) {
+		fields := map[string]interface{}{
+			"level": entry.Level.String(),
+		}
+		for key, value := range entry.Data {
+			fields[key] = fmt.Sprint(value)
+		}
+		data, _ := structpb.NewStruct(fields)
+		push(newToolCallEvent(mcpv1.ToolCallEventType_TOOL_CALL_EVENT_TYPE_LOG, data, entry.Message))
+	})
+
+	type callResult struct {
+		response *mcpv1.CallToolResponse
+		err      error
+	}
+	done := make(chan callResult, 1)
+	go func() {
+		response, err := s.invoke(callCtx, toolName, req.GetArguments(), 0)
+		done <- callResult{response: response, err: err}
+	}()
+
+	for {
+		select {
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case res := <-done:
+			// 先发送工具结束前产生的事件
+			for pending := len(events); pending > 0; pending-- {
+				if err := stream.Send(<-events); err != nil {
+					return err
+				}
+			}
+			if res.err != nil {
+				return res.err
+			}
+			return stream.Send(completionEvent(res.response))
+		}
+	}
+}
+
+// lookupTool 查找可用的工具，工具不存在或已禁用时返回 NotFound
+func (s *grpcToolService) lookupTool(toolName string) (*tools.ToolDefinition, error) {
+	if toolName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tool_name is required")
+	}
+	def, exists := s.registry.GetTool(toolName)
+	if !exists || !s.registry.IsToolEnabled(toolName) {
+		return nil, status.Errorf(codes.NotFound, "unknown tool: %s", toolName)
+	}
+	return def, nil
+}
+
+// acquire 获取工具调用并发名额，按客户端IP限制单客户端并发，同一客户端新建连接不能绕过上限
+// 返回的上下文带有客户端标识（用于异步任务的归属）和子调用获取名额的函数，batch_call 的首个子调用沿用本次获取的名额；
+// 工具超时后处理函数仍在运行时，返回的释放函数推迟到处理函数返回后才释放名额。
+// 排队已满或排队超时返回 ResourceExhausted，排队期间调用被取消返回对应的上下文错误
+func (s *grpcToolService) acquire(ctx context.Context, toolName string) (context.Context, func(), error) {
+	key := peerHost(ctx)
+	ctx = caller.WithIdentity(ctx, key)
+	if s.limiter == nil {
+		return ctx, func() {}, nil
+	}
+
+	release, err := s.limiter.Acquire(ctx, key)
+	var busyErr *transport.BusyError
+	if errors.As(err, &busyErr) {
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+			"client":    key,
+			"details":   busyErr.Details(),
+		}).Warn("Rejected gRPC tool call, server busy")
+		return nil, nil, status.Error(codes.ResourceExhausted, busyErr.Error())
+	}
+	if err != nil {
+		return nil, nil, status.FromContextError(err).Err()
+	}
+	// 工具超时后处理函数仍在运行时，名额保留到其返回
+	ctx, held := caller.WithSlot(caller.WithAcquirer(ctx, s.limiter.SubcallAcquirer(key, release)), release)
+	return ctx, held, nil
+}
+
+// peerHost 返回 gRPC 调用对端的IP
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "grpc"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// invoke 执行工具调用并统计执行指标
+// 调用受请求超时限制，timeoutSeconds 大于 0 时取两者中较短者
+func (s *grpcToolService) invoke(ctx context.Context, toolName string, arguments *structpb.Struct, timeoutSeconds int32) (*mcpv1.CallToolResponse, error) {
+	timeout := s.config.MCP.RequestTimeout
+	if requested := time.Duration(timeoutSeconds) * time.Second; requested > 0 && (timeout <= 0 || requested < timeout) {
+		timeout = requested
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Struct 的数值统一为 float64，与 JSON-RPC 请求解码后的参数一致
+	args := arguments.AsMap()
+
+	meter := startExecutionMeter()
+	result, err := s.registry.CallTool(ctx, toolName, args)
+	metrics := meter.stop()
+
+	response := &mcpv1.CallToolResponse{
+		Metrics:   metrics,
+		Timestamp: timestamppb.Now(),
+	}
+
+	var timeoutErr *tools.ToolTimeoutError
+	var toolErr *tools.ToolError
+	switch {
+	case errors.As(err, &timeoutErr):
+		response.ErrorMessage = timeoutErr.Error()
+		response.ErrorCode = "timeout"
+		response.Result, _ = toStruct(timeoutErr.Details())
+	case errors.As(err, &toolErr):
+		response.ErrorMessage = toolErr.Error()
+		response.ErrorCode = toolErr.ErrorCategory()
+		response.Result, _ = toStruct(toolErr.Details())
+	case err != nil:
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+			"error":     err.Error(),
+		}).Error("gRPC tool call failed")
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		return nil, status.Errorf(codes.Internal, "tool call failed: %v", err)
+	case result == nil:
+		return nil, status.Errorf(codes.NotFound, "unknown tool: %s", toolName)
+	default:
+		structResult, err := toStruct(result)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode result of tool %s: %v", toolName, err)
+		}
+		response.Success = true
+		response.Result = structResult
+	}
+
+	logger.WithFields(logrus.Fields{
+		"tool_name":         toolName,
+		"success":           response.Success,
+		"error_code":        response.ErrorCode,
+		"execution_time_ms": metrics.ExecutionTime,
+	}).Debug("gRPC tool call completed")
+
+	return response, nil
+}
+
+// toProtoTool 将工具定义转换为 proto 工具描述
+func (s *grpcToolService) toProtoTool(def *tools.ToolDefinition) (*mcpv1.Tool, error) {
+	schema, err := toStruct(def.InputSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	tool := &mcpv1.Tool{
+		Name:             def.Name,
+		Description:      def.Description,
+		ParametersSchema: schema,
+		Metadata: &mcpv1.ToolMetadata{
+			Version: s.config.MCP.Version,
+			Tags:    def.Categories,
+		},
+	}
+	if len(def.Categories) > 0 {
+		tool.Category = grpcCategories[def.Categories[0]]
+	}
+	return tool, nil
+}
+
+// hasGRPCCategory 判断工具的任一分类是否对应指定的 proto 工具分类
+func hasGRPCCategory(def *tools.ToolDefinition, category mcpv1.ToolCategory) bool {
+	for _, c := range def.Categories {
+		if grpcCategories[c] == category {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAllCategories 判断工具是否具备全部指定分类
+func hasAllCategories(def *tools.ToolDefinition, categories []string) bool {
+	for _, category := range categories {
+		found := false
+		for _, c := range def.Categories {
+			if c == category {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// toStruct 将 JSON 对象转换为 Struct
+// 工具结果中含有 []map[string]interface{} 等 structpb 不直接支持的类型，经 JSON 编码后再转换
+func toStruct(value interface{}) (*structpb.Struct, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	result := &structpb.Struct{}
+	if err := result.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// newToolCallEvent 创建工具调用事件
+func newToolCallEvent(eventType mcpv1.ToolCallEventType, data *structpb.Struct, message string) *mcpv1.ToolCallEvent {
+	return &mcpv1.ToolCallEvent{
+		Type:      eventType,
+		Data:      data,
+		Timestamp: timestamppb.Now(),
+		Message:   message,
+	}
+}
+
+// completionEvent 根据调用结果创建 COMPLETED 或 FAILED 事件，事件数据包含结果、错误分类和执行指标
+func completionEvent(response *mcpv1.CallToolResponse) *mcpv1.ToolCallEvent {
+	data := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"metrics": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"execution_time": structpb.NewNumberValue(response.Metrics.GetExecutionTime()),
+			"memory_used":    structpb.NewNumberValue(float64(response.Metrics.GetMemoryUsed())),
+			"cpu_time":       structpb.NewNumberValue(float64(response.Metrics.GetCpuTime())),
+		}}),
+	}}
+	if response.Result != nil {
+		data.Fields["result"] = structpb.NewStructValue(response.Result)
+	}
+
+	if response.Success {
+		return newToolCallEvent(mcpv1.ToolCallEventType_TOOL_CALL_EVENT_TYPE_COMPLETED, data, "tool call completed")
+	}
+	data.Fields["error_code"] = structpb.NewStringValue(response.ErrorCode)
+	return newToolCallEvent(mcpv1.ToolCallEventType_TOOL_CALL_EVENT_TYPE_FAILED, data, response.ErrorMessage)
+}
+
+// executionMeter 工具执行指标统计
+// 内存和 CPU 为执行期间整个进程的增量，并发调用时只能作为参考
+type executionMeter struct {
+	start      time.Time
+	totalAlloc uint64
+	cpuTime    time.Duration
+}
+
+// startExecutionMeter 开始统计执行指标
+func startExecutionMeter() *executionMeter {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return &executionMeter{
+		start:      time.Now(),
+		totalAlloc: memStats.TotalAlloc,
+		cpuTime:    processCPUTime(),
+	}
+}
+
+// stop 结束统计，execution_time 和 cpu_time 单位为毫秒，memory_used 为分配的字节数
+// 暂未统计云 API 调用次数，network_calls 为 0
+func (m *executionMeter) stop() *mcpv1.ToolExecutionMetrics {
+	elapsed := time.Since(m.start)
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return &mcpv1.ToolExecutionMetrics{
+		ExecutionTime: float64(elapsed.Microseconds()) / 1000,
+		MemoryUsed:    int64(memStats.TotalAlloc - m.totalAlloc),
+		CpuTime:       (processCPUTime() - m.cpuTime).Milliseconds(),
+	}
+}
+
+// serveGRPC 在配置的端口上启动 gRPC 服务
+func (s *MCPServer) serveGRPC(errChan chan<- error) {
+	address := fmt.Sprintf("%s:%d", s.config.Server.Host, s.config.Server.GRPCPort)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		errChan <- fmt.Errorf("gRPC listen error: %w", err)
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"address":      address,
+		"service":      mcpv1.MCPToolService_ServiceDesc.ServiceName,
+		"auth_enabled": s.config.MCP.Auth.Enabled,
+		"tls_enabled":  s.config.Server.GRPCTLSCertFile != "",
+	}).Info("Starting gRPC server")
+
+	if err := s.grpcServer.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+		errChan <- fmt.Errorf("gRPC server error: %w", err)
+	}
+}
+
+// stopGRPC 优雅关闭 gRPC 服务，超过关闭超时时间后强制关闭
+func (s *MCPServer) stopGRPC() {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(s.config.Server.ShutdownTimeout):
+		logger.Warn("gRPC graceful stop timed out, forcing stop")
+		s.grpcServer.Stop()
+	}
+	logger.Info("gRPC server stopped")
+}
@@ -0,0 +1,335 @@
+package server
+
+import (
+	"ai-sre/tools/mcp/internal/config"
+	"ai-sre/tools/mcp/internal/tools"
+)
+
+// buildOpenAPISpec 根据已启用的工具生成 OpenAPI 3.0 文档
+// 每个工具对应一个 POST /mcp/tools/{toolName}/call 路径，请求体的 arguments 即工具的 inputSchema，
+// 声明了输出类型的工具在响应中描述 result.structuredContent；异步调用的任务通过 /mcp/tasks/{taskId} 查询和取消
+func buildOpenAPISpec(cfg *config.Config) map[string]interface{} {
+	paths := map[string]interface{}{
+		restToolsPath: map[string]interface{}{
+			"get": map[string]interface{}{
+				"tags":        []string{"MCP"},
+				"summary":     "获取可用工具列表",
+				"operationId": "listTools",
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name":        "category",
+						"in":          "query",
+						"required":    false,
+						"description": "只返回指定分类的工具",
+						"schema": map[string]interface{}{
+							"type": "string",
+							"enum": tools.ToolCategories(),
+						},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": jsonResponse("成功返回工具列表", schemaRef("ToolsListResponse")),
+				},
+			},
+		},
+		restTasksPath + "/{taskId}": taskPathItem(),
+	}
+
+	registry := tools.GetGlobalRegistry()
+	for _, def := range registry.ListDefinitions() {
+		if !registry.IsToolEnabled(def.Name) {
+			continue
+		}
+		paths[restToolsPath+"/"+def.Name+restCallSuffix] = map[string]interface{}{
+			"post": toolOperation(def),
+		}
+	}
+
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       cfg.MCP.Name + " Tools API",
+			"description": "MCP 工具的 REST 接口，与 MCP JSON-RPC 端点 /mcp 共用同一个工具注册表",
+			"version":     cfg.MCP.Version,
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": "/"},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": openAPISchemas(),
+			"responses": map[string]interface{}{
+				"Error": jsonResponse("请求失败", schemaRef("ErrorResponse")),
+			},
+		},
+	}
+
+	if cfg.MCP.Auth.Enabled {
+		if scheme, ok := openAPISecurityScheme(cfg.MCP.Auth.Type); ok {
+			spec["components"].(map[string]interface{})["securitySchemes"] = map[string]interface{}{
+				"mcpAuth": scheme,
+			}
+			spec["security"] = []interface{}{
+				map[string]interface{}{"mcpAuth": []string{}},
+			}
+		}
+	}
+
+	return spec
+}
+
+// toolOperation 生成工具调用接口的 OpenAPI 描述
+func toolOperation(def *tools.ToolDefinition) map[string]interface{} {
+	tag := "general"
+	if len(def.Categories) > 0 {
+		tag = def.Categories[0]
+	}
+
+	resultSchema := map[string]interface{}{
+		"type":        "object",
+		"description": "与 MCP tools/call 的结果相同",
+		"properties": map[string]interface{}{
+			"content": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"type": "object"},
+			},
+		},
+	}
+	if def.OutputSchema != nil {
+		resultSchema["properties"].(map[string]interface{})["structuredContent"] = def.OutputSchema
+	}
+
+	errorResponse := map[string]interface{}{"$ref": "#/components/responses/Error"}
+
+	return map[string]interface{}{
+		"tags":        []string{tag},
+		"summary":     def.Name,
+		"description": def.Description,
+		"operationId": def.Name,
+		"requestBody": map[string]interface{}{
+			"required": false,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"arguments": def.InputSchema,
+							"options":   schemaRef("CallOptions"),
+						},
+					},
+				},
+			},
+		},
+		"responses": map[string]interface{}{
+			"200": jsonResponse("工具执行成功", map[string]interface{}{
+				"allOf": []interface{}{
+					schemaRef("ToolCallResponse"),
+					map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"result": resultSchema,
+						},
+					},
+				},
+			}),
+			"202": jsonResponse("已提交异步任务，Location 为任务查询地址", schemaRef("TaskResponse")),
+			"400": errorResponse,
+			"401": errorResponse,
+			"404": errorResponse,
+			"429": errorResponse,
+			"502": errorResponse,
+			"503": errorResponse,
+			"504": errorResponse,
+		},
+	}
+}
+
+// taskPathItem 生成异步任务查询和取消接口的 OpenAPI 描述
+func taskPathItem() map[string]interface{} {
+	parameters := []interface{}{
+		map[string]interface{}{
+			"name":     "taskId",
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		},
+	}
+	errorResponse := map[string]interface{}{"$ref": "#/components/responses/Error"}
+
+	return map[string]interface{}{
+		"parameters": parameters,
+		"get": map[string]interface{}{
+			"tags":        []string{"MCP"},
+			"summary":     "查询异步任务状态和结果",
+			"operationId": "getTask",
+			"responses": map[string]interface{}{
+				"200": jsonResponse("成功返回任务信息", schemaRef("TaskResponse")),
+				"401": errorResponse,
+				"404": errorResponse,
+			},
+		},
+		"delete": map[string]interface{}{
+			"tags":        []string{"MCP"},
+			"summary":     "取消异步任务",
+			"description": "取消尚未结束的任务，已结束的任务保持原状态",
+			"operationId": "cancelTask",
+			"responses": map[string]interface{}{
+				"200": jsonResponse("返回取消后的任务信息", schemaRef("TaskResponse")),
+				"401": errorResponse,
+				"404": errorResponse,
+			},
+		},
+	}
+}
+
+// openAPISchemas 通用的响应结构，与 specs/openapi/mcp-tools.yaml 一致
+func openAPISchemas() map[string]interface{} {
+	base := map[string]interface{}{
+		"success":    map[string]interface{}{"type": "boolean"},
+		"timestamp":  map[string]interface{}{"type": "string", "format": "date-time"},
+		"request_id": map[string]interface{}{"type": "string"},
+	}
+	withBase := func(properties map[string]interface{}, required ...string) map[string]interface{} {
+		merged := map[string]interface{}{}
+		for key, value := range base {
+			merged[key] = value
+		}
+		for key, value := range properties {
+			merged[key] = value
+		}
+		return map[string]interface{}{
+			"type":       "object",
+			"required":   append([]string{"success", "timestamp"}, required...),
+			"properties": merged,
+		}
+	}
+
+	return map[string]interface{}{
+		"ErrorResponse": withBase(map[string]interface{}{
+			"error": map[string]interface{}{
+				"type":     "object",
+				"required": []string{"code", "message"},
+				"properties": map[string]interface{}{
+					"code": map[string]interface{}{
+						"type":        "string",
+						"description": "错误码，工具执行失败时为错误分类(invalid_argument、not_found、rate_limited、auth、upstream)",
+					},
+					"message": map[string]interface{}{"type": "string"},
+					"details": map[string]interface{}{"type": "object"},
+				},
+			},
+		}, "error"),
+		"Tool": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"name", "description", "parameters"},
+			"properties": map[string]interface{}{
+				"name":          map[string]interface{}{"type": "string"},
+				"description":   map[string]interface{}{"type": "string"},
+				"parameters":    map[string]interface{}{"type": "object", "description": "工具参数Schema"},
+				"output_schema": map[string]interface{}{"type": "object", "description": "结构化结果Schema"},
+				"categories": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"type": "string"},
+				},
+				"endpoint": map[string]interface{}{"type": "string"},
+			},
+		},
+		"ToolsListResponse": withBase(map[string]interface{}{
+			"tools": map[string]interface{}{
+				"type":  "array",
+				"items": schemaRef("Tool"),
+			},
+		}, "tools"),
+		"ToolCallResponse": withBase(map[string]interface{}{
+			"result": map[string]interface{}{"type": "object"},
+			"metadata": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"tool":              map[string]interface{}{"type": "string"},
+					"execution_time_ms": map[string]interface{}{"type": "number"},
+				},
+			},
+		}, "result"),
+		"CallOptions": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"async": map[string]interface{}{
+					"type":        "boolean",
+					"description": "为 true 时提交异步任务并返回 202",
+				},
+				"callback_url": map[string]interface{}{
+					"type":        "string",
+					"format":      "uri",
+					"description": "任务结束后 POST 任务信息的地址，配置了签名密钥时携带 X-MCP-Signature",
+				},
+			},
+		},
+		"Task": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"task_id", "tool_name", "status", "created_at"},
+			"properties": map[string]interface{}{
+				"task_id":   map[string]interface{}{"type": "string"},
+				"tool_name": map[string]interface{}{"type": "string"},
+				"status": map[string]interface{}{
+					"type": "string",
+					"enum": []string{
+						tools.TaskStatusPending,
+						tools.TaskStatusRunning,
+						tools.TaskStatusSucceeded,
+						tools.TaskStatusFailed,
+						tools.TaskStatusCancelled,
+					},
+				},
+				"created_at":        map[string]interface{}{"type": "string", "format": "date-time"},
+				"started_at":        map[string]interface{}{"type": "string", "format": "date-time"},
+				"finished_at":       map[string]interface{}{"type": "string", "format": "date-time"},
+				"expires_at":        map[string]interface{}{"type": "string", "format": "date-time"},
+				"result":            map[string]interface{}{"type": "object", "description": "与 MCP tools/call 的结果相同"},
+				"error_code":        map[string]interface{}{"type": "string"},
+				"error_message":     map[string]interface{}{"type": "string"},
+				"error_details":     map[string]interface{}{"type": "object"},
+				"execution_time_ms": map[string]interface{}{"type": "number"},
+				"callback_url":      map[string]interface{}{"type": "string"},
+				"callback_status": map[string]interface{}{
+					"type": "string",
+					"enum": []string{tools.CallbackStatusPending, tools.CallbackStatusDelivered, tools.CallbackStatusFailed},
+				},
+				"callback_error": map[string]interface{}{"type": "string"},
+			},
+		},
+		"TaskResponse": withBase(map[string]interface{}{
+			"task": schemaRef("Task"),
+		}, "task"),
+	}
+}
+
+// openAPISecurityScheme 鉴权类型对应的 OpenAPI 安全方案
+func openAPISecurityScheme(authType string) (map[string]interface{}, bool) {
+	switch authType {
+	case "bearer":
+		return map[string]interface{}{"type": "http", "scheme": "bearer"}, true
+	case "basic":
+		return map[string]interface{}{"type": "http", "scheme": "basic"}, true
+	case "api_key":
+		return map[string]interface{}{"type": "apiKey", "in": "header", "name": "X-API-Key"}, true
+	default:
+		return nil, false
+	}
+}
+
+// jsonResponse 生成 application/json 响应描述
+func jsonResponse(description string, schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": schema,
+			},
+		},
+	}
+}
+
+// schemaRef 引用 components/schemas 中的结构
+func schemaRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
@@ -0,0 +1,366 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/config"
+	"ai-sre/tools/mcp/internal/tools"
+	"ai-sre/tools/mcp/internal/transport"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// REST 接口路径，与 specs/openapi/mcp-tools.yaml 一致
+const (
+	restToolsPath   = "/mcp/tools"
+	restCallSuffix  = "/call"
+	restTasksPath   = "/mcp/tasks"
+	openAPISpecPath = "/openapi.json"
+)
+
+// maxRESTBodySize 工具调用请求体的最大字节数
+const maxRESTBodySize = 1 << 20
+
+// REST 接口的错误码，工具执行失败时使用工具错误分类
+const (
+	restErrorInvalidRequest = "invalid_request"
+	restErrorToolNotFound   = "tool_not_found"
+	restErrorTaskNotFound   = "task_not_found"
+	restErrorServerBusy     = "server_busy"
+	restErrorTimeout        = "timeout"
+	restErrorInternal       = "internal_error"
+)
+
+// toolErrorStatus 工具错误分类对应的HTTP状态码
+// 云 API 鉴权失败说明服务器的云凭据有问题，与调用方的鉴权无关，按上游错误返回 502
+var toolErrorStatus = map[string]int{
+	tools.ErrorCategoryInvalidArgument: http.StatusBadRequest,
+	tools.ErrorCategoryNotFound:        http.StatusNotFound,
+	tools.ErrorCategoryRateLimited:     http.StatusTooManyRequests,
+	tools.ErrorCategoryAuth:            http.StatusBadGateway,
+	tools.ErrorCategoryUpstream:        http.StatusBadGateway,
+}
+
+// restToolsHandler REST 工具接口
+// GET /mcp/tools 列出可用工具，POST /mcp/tools/{toolName}/call 调用工具，与 /mcp 共用工具注册表、请求超时和并发限制
+func restToolsHandler(cfg *config.Config, mcpHandler *transport.MCPMessageHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+
+		if r.URL.Path == restToolsPath {
+			if r.Method != http.MethodGet {
+				w.Header().Set("Allow", "GET")
+				writeRESTError(w, requestID, http.StatusMethodNotAllowed, restErrorInvalidRequest, "method not allowed", nil)
+				return
+			}
+			handleRESTListTools(w, r, requestID)
+			return
+		}
+
+		toolName := strings.TrimPrefix(r.URL.Path, restToolsPath+"/")
+		if !strings.HasSuffix(toolName, restCallSuffix) {
+			writeRESTError(w, requestID, http.StatusNotFound, restErrorInvalidRequest, "not found, expected POST /mcp/tools/{toolName}/call", nil)
+			return
+		}
+		toolName = strings.TrimSuffix(toolName, restCallSuffix)
+		if toolName == "" || strings.Contains(toolName, "/") {
+			writeRESTError(w, requestID, http.StatusNotFound, restErrorInvalidRequest, "not found, expected POST /mcp/tools/{toolName}/call", nil)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			writeRESTError(w, requestID, http.StatusMethodNotAllowed, restErrorInvalidRequest, "method not allowed", nil)
+			return
+		}
+		handleRESTCallTool(w, r, cfg, mcpHandler.Limiter(), requestID, toolName)
+	}
+}
+
+// handleRESTListTools 列出已启用的工具，支持 ?category= 按分类过滤
+func handleRESTListTools(w http.ResponseWriter, r *http.Request, requestID string) {
+	category := r.URL.Query().Get("category")
+	registry := tools.GetGlobalRegistry()
+
+	toolList := make([]map[string]interface{}, 0)
+	for _, def := range registry.ListDefinitions() {
+		if !registry.IsToolEnabled(def.Name) {
+			continue
+		}
+		if category != "" && !hasAllCategories(def, []string{category}) {
+			continue
+		}
+		tool := map[string]interface{}{
+			"name":        def.Name,
+			"description": def.Description,
+			"parameters":  def.InputSchema,
+			"categories":  def.Categories,
+			"endpoint":    restToolsPath + "/" + def.Name + restCallSuffix,
+		}
+		if def.OutputSchema != nil {
+			tool["output_schema"] = def.OutputSchema
+		}
+		toolList = append(toolList, tool)
+	}
+
+	writeRESTJSON(w, http.StatusOK, map[string]interface{}{
+		"success":    true,
+		"timestamp":  time.Now().UTC().Format(time.RFC3339),
+		"request_id": requestID,
+		"tools":      toolList,
+	})
+}
+
+// handleRESTCallTool 调用工具，请求体为 {"arguments": {...}, "options": {...}}，arguments 省略时按无参数调用
+// options.async 为 true 时提交异步任务并返回 202，Location 指向任务查询地址；
+// 参数错误返回 400，工具不存在或已禁用返回 404，服务繁忙返回 503，超时返回 504，云 API 失败返回 502
+func handleRESTCallTool(w http.ResponseWriter, r *http.Request, cfg *config.Config, limiter *transport.ConcurrencyLimiter, requestID, toolName string) {
+	registry := tools.GetGlobalRegistry()
+	if _, exists := registry.GetTool(toolName); !exists || !registry.IsToolEnabled(toolName) {
+		writeRESTError(w, requestID, http.StatusNotFound, restErrorToolNotFound, "unknown tool: "+toolName, nil)
+		return
+	}
+
+	var request struct {
+		Arguments map[string]interface{} `json:"arguments"`
+		Options   struct {
+			Async       bool   `json:"async"`
+			CallbackURL string `json:"callback_url"`
+		} `json:"options"`
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRESTBodySize+1))
+	if err != nil {
+		writeRESTError(w, requestID, http.StatusBadRequest, restErrorInvalidRequest, "failed to read request body", nil)
+		return
+	}
+	if len(body) > maxRESTBodySize {
+		writeRESTError(w, requestID, http.StatusRequestEntityTooLarge, restErrorInvalidRequest, "request body too large", nil)
+		return
+	}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := json.Unmarshal(body, &request); err != nil {
+			writeRESTError(w, requestID, http.StatusBadRequest, restErrorInvalidRequest, "invalid JSON body: "+err.Error(), nil)
+			return
+		}
+	}
+
+	if request.Options.Async {
+		handleRESTSubmitTask(w, requestID, remoteHost(r), toolName, request.Arguments, request.Options.CallbackURL)
+		return
+	}
+
+	extendWriteDeadline(w, cfg.MCP.RequestTimeout)
+
+	// 客户端IP作为调用方标识，get_task_result、cancel_task 只能访问同一客户端提交的任务
+	ctx := caller.WithIdentity(r.Context(), remoteHost(r))
+	if cfg.MCP.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.MCP.RequestTimeout)
+		defer cancel()
+	}
+
+	// 并发已满时排队等待，与 /mcp 等端点共用按客户端IP计算的单客户端并发上限
+	if limiter != nil {
+		key := remoteHost(r)
+		release, err := limiter.Acquire(ctx, key)
+		var busyErr *transport.BusyError
+		if errors.As(err, &busyErr) {
+			retryAfter := int(math.Ceil(busyErr.RetryAfter.Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			writeRESTError(w, requestID, http.StatusServiceUnavailable, restErrorServerBusy, busyErr.Error(), busyErr.Details())
+			return
+		}
+		if err != nil {
+			writeRESTError(w, requestID, http.StatusGatewayTimeout, restErrorTimeout, err.Error(), nil)
+			return
+		}
+
+		// batch_call 的每个子调用单独获取名额，首个子调用沿用本次调用已占用的名额
+		ctx = caller.WithAcquirer(ctx, limiter.SubcallAcquirer(key, release))
+		// 工具超时后处理函数仍在运行时，名额保留到其返回
+		ctx, release = caller.WithSlot(ctx, release)
+		defer release()
+	}
+
+	meter := startExecutionMeter()
+	result, err := registry.CallTool(ctx, toolName, request.Arguments)
+	metrics := meter.stop()
+
+	var timeoutErr *tools.ToolTimeoutError
+	var toolErr *tools.ToolError
+	switch {
+	case errors.As(err, &timeoutErr):
+		writeRESTError(w, requestID, http.StatusGatewayTimeout, restErrorTimeout, timeoutErr.Error(), timeoutErr.Details())
+		return
+	case errors.As(err, &toolErr):
+		status, ok := toolErrorStatus[toolErr.ErrorCategory()]
+		if !ok {
+			status = http.StatusBadGateway
+		}
+		logger.WithFields(logrus.Fields{
+			"tool_name":  toolName,
+			"request_id": requestID,
+			"category":   toolErr.ErrorCategory(),
+			"error":      toolErr.Error(),
+		}).Warn("REST tool call failed")
+		writeRESTError(w, requestID, status, toolErr.ErrorCategory(), toolErr.Error(), toolErr.Details())
+		return
+	case err != nil:
+		logger.WithFields(logrus.Fields{
+			"tool_name":  toolName,
+			"request_id": requestID,
+			"error":      err.Error(),
+		}).Error("REST tool call failed")
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			writeRESTError(w, requestID, http.StatusGatewayTimeout, restErrorTimeout, ctxErr.Error(), nil)
+			return
+		}
+		writeRESTError(w, requestID, http.StatusInternalServerError, restErrorInternal, err.Error(), nil)
+		return
+	case result == nil:
+		writeRESTError(w, requestID, http.StatusNotFound, restErrorToolNotFound, "unknown tool: "+toolName, nil)
+		return
+	}
+
+	writeRESTJSON(w, http.StatusOK, map[string]interface{}{
+		"success":    true,
+		"timestamp":  time.Now().UTC().Format(time.RFC3339),
+		"request_id": requestID,
+		"result":     result,
+		"metadata": map[string]interface{}{
+			"tool":              toolName,
+			"execution_time_ms": metrics.ExecutionTime,
+		},
+	})
+}
+
+// handleRESTSubmitTask 以客户端IP作为任务所有者提交异步工具调用，返回 202 和任务信息
+func handleRESTSubmitTask(w http.ResponseWriter, requestID, owner, toolName string, arguments map[string]interface{}, callbackURL string) {
+	task, err := tools.GetTaskManager().Submit(owner, toolName, arguments, callbackURL)
+	var toolErr *tools.ToolError
+	if errors.As(err, &toolErr) {
+		status, ok := toolErrorStatus[toolErr.ErrorCategory()]
+		if !ok {
+			status = http.StatusInternalServerError
+		}
+		writeRESTError(w, requestID, status, toolErr.ErrorCategory(), toolErr.Error(), toolErr.Details())
+		return
+	}
+	if err != nil {
+		writeRESTError(w, requestID, http.StatusInternalServerError, restErrorInternal, err.Error(), nil)
+		return
+	}
+
+	w.Header().Set("Location", restTasksPath+"/"+task.TaskID)
+	writeRESTJSON(w, http.StatusAccepted, map[string]interface{}{
+		"success":    true,
+		"timestamp":  time.Now().UTC().Format(time.RFC3339),
+		"request_id": requestID,
+		"task":       task,
+	})
+}
+
+// restTasksHandler 异步任务接口
+// GET /mcp/tasks/{taskId} 查询任务状态和结果，DELETE /mcp/tasks/{taskId} 取消任务；
+// 只能访问同一客户端IP提交的任务，任务不存在、已过期或不属于该客户端返回 404
+func restTasksHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+
+		taskID := strings.TrimPrefix(r.URL.Path, restTasksPath+"/")
+		if taskID == "" || strings.Contains(taskID, "/") {
+			writeRESTError(w, requestID, http.StatusNotFound, restErrorInvalidRequest, "not found, expected /mcp/tasks/{taskId}", nil)
+			return
+		}
+
+		var task *tools.TaskInfo
+		var exists bool
+		switch r.Method {
+		case http.MethodGet:
+			task, exists = tools.GetTaskManager().Get(taskID, remoteHost(r))
+		case http.MethodDelete:
+			task, exists = tools.GetTaskManager().Cancel(taskID, remoteHost(r))
+		default:
+			w.Header().Set("Allow", "GET, DELETE")
+			writeRESTError(w, requestID, http.StatusMethodNotAllowed, restErrorInvalidRequest, "method not allowed", nil)
+			return
+		}
+		if !exists {
+			writeRESTError(w, requestID, http.StatusNotFound, restErrorTaskNotFound, "unknown or expired task: "+taskID, nil)
+			return
+		}
+
+		writeRESTJSON(w, http.StatusOK, map[string]interface{}{
+			"success":    true,
+			"timestamp":  time.Now().UTC().Format(time.RFC3339),
+			"request_id": requestID,
+			"task":       task,
+		})
+	}
+}
+
+// openAPIHandler 返回根据已启用工具生成的 OpenAPI 文档
+func openAPIHandler(cfg *config.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeRESTJSON(w, http.StatusOK, buildOpenAPISpec(cfg))
+	}
+}
+
+// writeRESTError 按 specs/openapi/mcp-tools.yaml 中的 ErrorResponse 格式返回错误
+func writeRESTError(w http.ResponseWriter, requestID string, status int, code, message string, details map[string]interface{}) {
+	errorBody := map[string]interface{}{
+		"code":    code,
+		"message": message,
+	}
+	if details != nil {
+		errorBody["details"] = details
+	}
+	writeRESTJSON(w, status, map[string]interface{}{
+		"success":    false,
+		"timestamp":  time.Now().UTC().Format(time.RFC3339),
+		"request_id": requestID,
+		"error":      errorBody,
+	})
+}
+
+// writeRESTJSON 以JSON格式写入响应
+func writeRESTJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// remoteHost 返回请求的对端IP
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
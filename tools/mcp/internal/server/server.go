@@ -13,11 +13,12 @@ import (
 	"syscall"
 	"time"
 
-	mcp "github.com/metoro-io/mcp-golang"
-	"github.com/metoro-io/mcp-golang/transport/stdio"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	"ai-sre/tools/mcp/internal/auth"
+	"ai-sre/tools/mcp/internal/caller"
 	"ai-sre/tools/mcp/internal/config"
+	"ai-sre/tools/mcp/internal/prompts"
 	"ai-sre/tools/mcp/internal/tools"
 	"ai-sre/tools/mcp/internal/transport"
 	"ai-sre/tools/mcp/pkg/logger"
@@ -26,29 +27,38 @@ import (
 // MCPServer MCP服务器结构
 type MCPServer struct {
 	config         *config.Config
-	server         *mcp.Server
-	transport      *stdio.StdioServerTransport
+	stdioTransport *transport.StdioTransport // stdio传输层（用于stdio模式）
 	httpServer     *http.Server // HTTP服务器（用于HTTP和SSE模式）
 	httpTransport  *transport.HTTPTransport // HTTP MCP传输层
 	mcpHandler     *transport.MCPMessageHandler // MCP消息处理器
+	grpcServer     *grpc.Server // gRPC服务器（MCPToolService，未启用时为nil）
+	grpcErr        error // gRPC服务器创建失败的原因，启动时返回
 	authMiddleware *auth.AuthMiddleware
-	tools          map[string]interface{}
+	tools          map[string]*tools.ToolDefinition
+	toolOrder      []string // 工具注册顺序，保证 tools/list 输出稳定
 	mutex          sync.RWMutex
 }
 
 // NewMCPServer 创建新的MCP服务器实例
 func NewMCPServer(cfg *config.Config) *MCPServer {
-	var stdioTransport *stdio.StdioServerTransport
+	var stdioTransport *transport.StdioTransport
 	var httpServer *http.Server
 	var httpTransport *transport.HTTPTransport
-	var mcpHandler *transport.MCPMessageHandler
 	var authMiddleware *auth.AuthMiddleware
 
+	// 创建MCP消息处理器，所有传输模式共用同一套协议实现
+	mcpHandler := transport.NewMCPMessageHandler(nil)
+
+	server := &MCPServer{
+		config: cfg,
+		tools:  make(map[string]*tools.ToolDefinition),
+	}
+
 	// 根据配置的传输模式创建相应的传输层
 	switch cfg.MCP.Transport {
 	case "stdio":
-		stdioTransport = stdio.NewStdioServerTransport()
-	case "sse", "http":
+		stdioTransport = transport.NewStdioTransport(mcpHandler, os.Stdin, os.Stdout)
+	case "sse", "http", "websocket":
 		// 创建鉴权中间件
 		if cfg.MCP.Auth.Enabled {
 			authMiddleware = auth.NewAuthMiddleware(&cfg.MCP.Auth)
@@ -57,14 +67,38 @@ func NewMCPServer(cfg *config.Config) *MCPServer {
 		// 创建HTTP服务器，集成MCP传输和管理端点
 		mux := http.NewServeMux()
 		
-		// 创建MCP消息处理器
-		mcpHandler = transport.NewMCPMessageHandler(nil) // 暂时传nil，稍后设置
-		
 		// 添加MCP协议端点（不需要认证，MCP协议自己处理）
 		mux.HandleFunc("/mcp", func(w http.ResponseWriter, r *http.Request) {
 			handleMCPRequest(w, r, mcpHandler)
 		})
 		
+		// sse模式额外提供旧版 HTTP+SSE 传输 (2024-11-05)
+		if cfg.MCP.Transport == "sse" {
+			sseHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handleLegacySSE(w, r, mcpHandler)
+			})
+			messagesHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handleLegacyMessages(w, r, mcpHandler)
+			})
+			if authMiddleware != nil {
+				mux.Handle("/sse", authMiddleware.Handler(sseHandler))
+				mux.Handle(legacySSEMessagesPath, authMiddleware.Handler(messagesHandler))
+			} else {
+				mux.Handle("/sse", sseHandler)
+				mux.Handle(legacySSEMessagesPath, messagesHandler)
+			}
+		}
+		
+		// websocket模式额外提供 WebSocket 传输，鉴权在握手时完成
+		websocketCtx, websocketCancel := context.WithCancel(context.Background())
+		if cfg.MCP.Transport == "websocket" {
+			if authMiddleware != nil {
+				mux.Handle(websocketPath, authMiddleware.Handler(websocketHandler(websocketCtx, cfg, mcpHandler)))
+			} else {
+				mux.HandleFunc(websocketPath, websocketHandler(websocketCtx, cfg, mcpHandler))
+			}
+		}
+		
 		// 添加通用管理端点（应用认证中间件）
 		if authMiddleware != nil {
 			mux.Handle("/", authMiddleware.Handler(rootHandler(cfg)))
@@ -76,21 +110,29 @@ func NewMCPServer(cfg *config.Config) *MCPServer {
 			mux.HandleFunc("/status", generalStatusHandler(cfg))
 		}
 		
-		// 添加MCP管理端点（应用认证中间件）
+		// 添加MCP管理端点和REST工具接口（应用认证中间件）
 		if authMiddleware != nil {
 			mux.Handle("/mcp/manage", authMiddleware.Handler(mcpRootHandler(cfg)))
 			mux.Handle("/mcp/manage/", authMiddleware.Handler(mcpRootHandler(cfg)))
 			mux.Handle("/mcp/manage/health", authMiddleware.Handler(mcpHealthHandler(cfg)))
-			mux.Handle("/mcp/manage/status", authMiddleware.Handler(mcpStatusHandler(cfg)))
-			mux.Handle("/mcp/manage/info", authMiddleware.Handler(mcpInfoHandler(cfg)))
-			mux.Handle("/mcp/manage/tools", authMiddleware.Handler(mcpToolsHandler(cfg)))
+			mux.Handle("/mcp/manage/status", authMiddleware.Handler(mcpStatusHandler(cfg, server)))
+			mux.Handle("/mcp/manage/info", authMiddleware.Handler(mcpInfoHandler(cfg, server)))
+			mux.Handle("/mcp/manage/tools", authMiddleware.Handler(mcpToolsHandler(cfg, server)))
+			mux.Handle(restToolsPath, authMiddleware.Handler(restToolsHandler(cfg, mcpHandler)))
+			mux.Handle(restToolsPath+"/", authMiddleware.Handler(restToolsHandler(cfg, mcpHandler)))
+			mux.Handle(restTasksPath+"/", authMiddleware.Handler(restTasksHandler()))
+			mux.Handle(openAPISpecPath, authMiddleware.Handler(openAPIHandler(cfg)))
 		} else {
 			mux.HandleFunc("/mcp/manage", mcpRootHandler(cfg))
 			mux.HandleFunc("/mcp/manage/", mcpRootHandler(cfg))
 			mux.HandleFunc("/mcp/manage/health", mcpHealthHandler(cfg))
-			mux.HandleFunc("/mcp/manage/status", mcpStatusHandler(cfg))
-			mux.HandleFunc("/mcp/manage/info", mcpInfoHandler(cfg))
-			mux.HandleFunc("/mcp/manage/tools", mcpToolsHandler(cfg))
+			mux.HandleFunc("/mcp/manage/status", mcpStatusHandler(cfg, server))
+			mux.HandleFunc("/mcp/manage/info", mcpInfoHandler(cfg, server))
+			mux.HandleFunc("/mcp/manage/tools", mcpToolsHandler(cfg, server))
+			mux.HandleFunc(restToolsPath, restToolsHandler(cfg, mcpHandler))
+			mux.HandleFunc(restToolsPath+"/", restToolsHandler(cfg, mcpHandler))
+			mux.HandleFunc(restTasksPath+"/", restTasksHandler())
+			mux.HandleFunc(openAPISpecPath, openAPIHandler(cfg))
 		}
 		
 		httpServer = &http.Server{
@@ -100,6 +142,8 @@ func NewMCPServer(cfg *config.Config) *MCPServer {
 			WriteTimeout: cfg.Server.WriteTimeout,
 			IdleTimeout:  cfg.Server.IdleTimeout,
 		}
+		// 关闭HTTP服务器时一并关闭WebSocket连接（Shutdown不跟踪已接管的连接）
+		httpServer.RegisterOnShutdown(websocketCancel)
 		
 		// 保存mcpHandler引用，稍后设置MCPServer引用
 		httpTransport = &transport.HTTPTransport{}
@@ -116,85 +160,133 @@ func NewMCPServer(cfg *config.Config) *MCPServer {
 			"fallback_transport": "stdio",
 		}).Warn("Invalid transport mode, falling back to stdio")
 		cfg.MCP.Transport = "stdio"
-		stdioTransport = stdio.NewStdioServerTransport()
+		stdioTransport = transport.NewStdioTransport(mcpHandler, os.Stdin, os.Stdout)
 	}
 
-	// 创建MCP服务器
-	var mcpServer *mcp.Server
-	if stdioTransport != nil {
-		mcpServer = mcp.NewServer(stdioTransport)
-	} else {
-		// HTTP模式下创建一个虚拟的stdio服务器用于工具注册
-		mcpServer = mcp.NewServer(stdio.NewStdioServerTransport())
-	}
+	server.stdioTransport = stdioTransport
+	server.httpServer = httpServer
+	server.httpTransport = httpTransport
+	server.mcpHandler = mcpHandler
+	server.authMiddleware = authMiddleware
 
-	server := &MCPServer{
-		config:         cfg,
-		server:         mcpServer,
-		transport:      stdioTransport,
-		httpServer:     httpServer,
-		httpTransport:  httpTransport,
-		mcpHandler:     mcpHandler,
-		authMiddleware: authMiddleware,
-		tools:          make(map[string]interface{}),
+	// 设置会话管理器（空闲会话按配置过期）
+	mcpHandler.SetSessionManager(transport.NewSessionManager(cfg.MCP.SessionTimeout, cfg.MCP.EventBufferSize, cfg.MCP.MaxSessions, cfg.MCP.MaxSessionsPerClient))
+	// 设置MCPServer引用
+	mcpHandler.SetMCPServer(server)
+	// 设置请求超时和工具执行超时（可按工具覆盖）
+	mcpHandler.SetRequestTimeout(cfg.MCP.RequestTimeout)
+	tools.GetGlobalRegistry().SetExecutionTimeouts(cfg.Tools.ExecutionTimeout, cfg.Tools.ToolTimeouts)
+	// 设置工具调用并发限制（全局 + 单客户端，超出时排队）
+	mcpHandler.SetConcurrencyLimiter(transport.NewConcurrencyLimiter(
+		cfg.MCP.MaxConcurrentRequests,
+		cfg.MCP.MaxConcurrentPerClient,
+		cfg.MCP.MaxQueuedRequests,
+		cfg.MCP.QueueTimeout,
+	))
+	// 设置 MCP 日志能力（logging/setLevel 及工具调用日志转发）
+	mcpHandler.SetLoggingEnabled(cfg.MCP.Capabilities.Logging)
+	// 设置工具注册表（所有工具统一通过全局注册表调用）
+	mcpHandler.SetToolRegistry(tools.GetGlobalRegistry())
+	// 设置 tools/list 分页大小
+	mcpHandler.SetToolsPageSize(cfg.MCP.ToolsPageSize)
+	// 设置工具过滤规则，运行时启用/禁用工具时通知所有会话
+	tools.GetGlobalRegistry().SetToolFilter(cfg.Tools.AllowedTools, cfg.Tools.DisabledTools)
+	tools.GetGlobalRegistry().SetListChangedHandler(mcpHandler.NotifyToolListChanged)
+	// 设置异步任务（tools/call 的 _meta.async 及 get_task_result 工具）
+	tools.GetTaskManager().Configure(cfg.Tools.TaskRetention, cfg.Tools.TaskTimeout, cfg.Tools.MaxRunningTasks, cfg.Tools.MaxRunningTasksPerClient, cfg.Tools.TaskCallbackSecret)
+	tools.GetTaskManager().SetCallbackAllowedHosts(cfg.Tools.TaskCallbackAllowedHosts)
+	tools.GetTaskManager().SetLimiter(mcpHandler.Limiter())
+	mcpHandler.SetTaskSubmitter(tools.GetTaskManager())
+	// 设置参数补全器（completion/complete）
+	mcpHandler.SetCompleter(tools.GetArgumentCompleter())
+	// 设置资源提供者（resources/read、资源模板及订阅）
+	if cfg.MCP.Capabilities.Resources {
+		mcpHandler.SetResourceProvider(tools.GetResourceManager())
 	}
-
-	// 如果有mcpHandler，设置MCPServer引用
-	if mcpHandler != nil {
-		mcpHandler.SetMCPServer(server)
-		// 设置工具注册表（所有工具统一通过全局注册表调用）
-		mcpHandler.SetToolRegistry(tools.GetGlobalRegistry())
+	// 设置提示模板库（内置提示模板 + 提示模板目录）
+	if cfg.MCP.Capabilities.Prompts {
+		library := prompts.NewLibrary()
+		if cfg.MCP.PromptsDir != "" {
+			if _, err := library.LoadDir(cfg.MCP.PromptsDir); err != nil {
+				logger.WithFields(logrus.Fields{
+					"dir":   cfg.MCP.PromptsDir,
+					"error": err.Error(),
+				}).Error("Failed to load prompts directory, continuing with loaded prompts")
+			}
+		}
+		mcpHandler.SetPromptProvider(library)
+	}
+	// 创建gRPC服务（与MCP共用工具注册表、请求超时、并发限制和鉴权配置）
+	if cfg.Server.GRPCEnabled {
+		grpcAuth := authMiddleware
+		if grpcAuth == nil && cfg.MCP.Auth.Enabled {
+			grpcAuth = auth.NewAuthMiddleware(&cfg.MCP.Auth)
+		}
+		server.grpcServer, server.grpcErr = newGRPCServer(cfg, mcpHandler.Limiter(), grpcAuth)
 	}
 
 	return server
 }
 
 // RegisterTool 注册工具到MCP服务器
-func (s *MCPServer) RegisterTool(name, description string, handler interface{}) error {
+// 工具调用统一经由全局工具注册表分发，这里只记录tools/list所需的描述信息
+func (s *MCPServer) RegisterTool(def *tools.ToolDefinition) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	// 使用mcp-golang库的RegisterTool方法
-	if err := s.server.RegisterTool(name, description, handler); err != nil {
-		logger.WithFields(logrus.Fields{
-			"tool_name": name,
-			"error":     err.Error(),
-		}).Error("Failed to register tool")
-		return fmt.Errorf("failed to register tool %s: %w", name, err)
-	}
-
 	// 记录到本地工具映射
-	s.tools[name] = handler
+	if _, exists := s.tools[def.Name]; !exists {
+		s.toolOrder = append(s.toolOrder, def.Name)
+	}
+	s.tools[def.Name] = def
 
 	logger.WithFields(logrus.Fields{
-		"tool_name":        name,
-		"tool_description": description,
+		"tool_name":        def.Name,
+		"tool_description": def.Description,
 	}).Info("Tool registered successfully")
 
 	return nil
 }
 
-// GetRegisteredTools 获取已注册的工具列表
+// GetRegisteredTools 获取已注册且可用的工具列表，被配置或运行时禁用的工具不包含在内
 func (s *MCPServer) GetRegisteredTools() []string {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	tools := make([]string, 0, len(s.tools))
-	for name := range s.tools {
-		tools = append(tools, name)
+	registry := tools.GetGlobalRegistry()
+	enabled := make([]string, 0, len(s.toolOrder))
+	for _, name := range s.toolOrder {
+		if registry.IsToolEnabled(name) {
+			enabled = append(enabled, name)
+		}
 	}
-	return tools
+	return enabled
 }
 
-// GetToolCount 获取工具数量
-func (s *MCPServer) GetToolCount() int {
+// GetToolInfo 获取工具的MCP描述信息（name、description、inputSchema）
+func (s *MCPServer) GetToolInfo(toolName string) (map[string]interface{}, bool) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
-	return len(s.tools)
+
+	def, exists := s.tools[toolName]
+	if !exists {
+		return nil, false
+	}
+
+	return def.ToMCPTool(), true
+}
+
+// GetToolCount 获取可用工具数量
+func (s *MCPServer) GetToolCount() int {
+	return len(s.GetRegisteredTools())
 }
 
 // Start 启动MCP服务器
 func (s *MCPServer) Start(ctx context.Context) error {
+	if s.grpcErr != nil {
+		return s.grpcErr
+	}
+
 	logger.WithFields(logrus.Fields{
 		"server_name":      s.config.MCP.Name,
 		"server_version":   s.config.MCP.Version,
@@ -211,18 +303,36 @@ func (s *MCPServer) Start(ctx context.Context) error {
 	// 启动错误通道
 	errChan := make(chan error, 3)
 
+	// 定期刷新被订阅的资源，内容变化时通知订阅的会话
+	if s.config.MCP.Capabilities.Resources {
+		tools.GetResourceManager().StartRefresh(serverCtx, s.config.MCP.ResourceRefreshInterval)
+	}
+
+	// 异步任务随服务器关闭而取消，并定期清理过期的任务
+	tools.GetTaskManager().Start(serverCtx)
+
+	// 启动gRPC服务，与传输模式无关
+	if s.grpcServer != nil {
+		go s.serveGRPC(errChan)
+	}
+
 	// 根据传输模式启动相应的服务
 	switch s.config.MCP.Transport {
 	case "stdio":
-		// 启动stdio MCP服务器
+		// 启动stdio MCP服务器，输入结束时关闭服务器
 		go func() {
 			logger.Info("Starting MCP protocol server (stdio)")
-			if err := s.server.Serve(); err != nil {
+			if err := s.stdioTransport.Serve(serverCtx); err != nil {
 				errChan <- fmt.Errorf("MCP server error: %w", err)
+				return
 			}
+			cancel()
 		}()
 		
-	case "http", "sse":
+	case "http", "sse", "websocket":
+		// 定期清理空闲会话
+		s.mcpHandler.Sessions().StartCleanup(serverCtx)
+		
 		// 启动HTTP服务器（包含MCP传输和管理端点）
 		if s.httpServer != nil {
 			go func() {
@@ -242,7 +352,22 @@ func (s *MCPServer) Start(ctx context.Context) error {
 			"address":   s.config.GetServerAddress(),
 			"mcp_endpoint": "/mcp",
 			"management_endpoints": []string{"/", "/health", "/status", "/mcp/manage"},
+			"rest_endpoints": []string{restToolsPath, restTasksPath, openAPISpecPath},
 		}).Info("HTTP server with MCP transport started")
+		
+		if s.config.MCP.Transport == "sse" {
+			logger.WithFields(logrus.Fields{
+				"sse_endpoint":      "/sse",
+				"messages_endpoint": legacySSEMessagesPath,
+			}).Info("Legacy HTTP+SSE transport enabled")
+		}
+		
+		if s.config.MCP.Transport == "websocket" {
+			logger.WithFields(logrus.Fields{
+				"websocket_endpoint": websocketPath,
+				"ping_interval":      s.config.MCP.WebSocketPingInterval.String(),
+			}).Info("WebSocket transport enabled")
+		}
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -275,6 +400,11 @@ func (s *MCPServer) Start(ctx context.Context) error {
 func (s *MCPServer) shutdown() error {
 	logger.Info("Shutting down MCP server")
 
+	// 如果启用了gRPC服务，优雅关闭
+	if s.grpcServer != nil {
+		s.stopGRPC()
+	}
+
 	// 如果有HTTP服务器，优雅关闭
 	if s.httpServer != nil {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.Server.ShutdownTimeout)
@@ -287,13 +417,6 @@ func (s *MCPServer) shutdown() error {
 		logger.Info("HTTP server stopped")
 	}
 
-	// 关闭MCP传输
-	if s.transport != nil {
-		if err := s.transport.Close(); err != nil {
-			logger.WithError(err).Error("MCP transport close error")
-		}
-	}
-
 	logger.Info("MCP server stopped")
 	return nil
 }
@@ -576,11 +699,22 @@ func mcpRootHandler(cfg *config.Config) http.HandlerFunc {
 }
 
 // mcpStatusHandler MCP专用状态处理器
-func mcpStatusHandler(cfg *config.Config) http.HandlerFunc {
+func mcpStatusHandler(cfg *config.Config, server *MCPServer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		
+		// 并发限制器状态（进行中、排队中的工具调用数）和活跃会话数
+		concurrency := transport.LimiterStats{}
+		sessionCount := 0
+		if server.mcpHandler != nil {
+			if limiter := server.mcpHandler.Limiter(); limiter != nil {
+				concurrency = limiter.Stats()
+			}
+			sessionCount = server.mcpHandler.Sessions().Count()
+		}
+		concurrencyJSON, _ := json.Marshal(concurrency)
+		
 		response := fmt.Sprintf(`{
 		"service": "ai-sre-mcp-server",
 		"status": "running",
@@ -591,6 +725,8 @@ func mcpStatusHandler(cfg *config.Config) http.HandlerFunc {
 			"enabled": %t,
 			"type": "%s"
 		},
+		"concurrency": %s,
+		"sessions": %d,
 		"endpoints": {
 			"root": "/mcp",
 			"health": "/mcp/health",
@@ -598,20 +734,20 @@ func mcpStatusHandler(cfg *config.Config) http.HandlerFunc {
 			"info": "/mcp/info",
 			"tools": "/mcp/tools"
 		}
-	}`, time.Now().UTC().Format(time.RFC3339), cfg.MCP.Transport, cfg.MCP.Version, cfg.MCP.Auth.Enabled, cfg.MCP.Auth.Type)
+	}`, time.Now().UTC().Format(time.RFC3339), cfg.MCP.Transport, cfg.MCP.Version, cfg.MCP.Auth.Enabled, cfg.MCP.Auth.Type, string(concurrencyJSON), sessionCount)
 		
 		w.Write([]byte(response))
 	}
 }
 
 // mcpInfoHandler MCP专用信息处理器
-func mcpInfoHandler(cfg *config.Config) http.HandlerFunc {
+func mcpInfoHandler(cfg *config.Config, server *MCPServer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		
-		// 这里应该从实际的MCPServer获取工具列表，但由于架构限制，暂时使用默认列表
-		tools := []string{"ping", "echo", "system_info", "describe_regions", "get_region", "tencentcloud_validate", "tke_describe_clusters", "tke_describe_cluster_extra_args", "tke_get_cluster_level_price", "tke_describe_addon", "tke_get_app_chart_list", "tke_describe_images", "tke_describe_versions", "tke_describe_log_switches", "tke_describe_master_component", "tke_describe_cluster_instances", "tke_describe_cluster_virtual_node", "cvm_describe_instances", "cvm_describe_instances_status", "clb_describe_load_balancers", "clb_describe_listeners", "clb_describe_targets", "clb_describe_target_health", "cdb_describe_db_instances", "cdb_describe_db_instance_info", "cdb_describe_slow_logs", "cdb_describe_error_log", "vpc_describe_vpcs", "vpc_describe_subnets", "vpc_describe_security_groups", "vpc_describe_network_interfaces", "vpc_describe_addresses", "vpc_describe_bandwidth_packages", "vpc_describe_vpc_endpoint", "vpc_describe_vpc_endpoint_service", "vpc_describe_vpc_peering_connections"}
+		// 从MCPServer获取实际注册的工具列表
+		tools := server.GetRegisteredTools()
 		toolsJSON, _ := json.Marshal(tools)
 		
 		response := fmt.Sprintf(`{
@@ -636,208 +772,115 @@ func mcpInfoHandler(cfg *config.Config) http.HandlerFunc {
 }
 
 // mcpToolsHandler MCP工具列表处理器
-func mcpToolsHandler(cfg *config.Config) http.HandlerFunc {
+// GET 列出所有注册的工具及其可用状态；POST {"name": "...", "enabled": true|false} 在运行时启用或禁用工具
+func mcpToolsHandler(cfg *config.Config, server *MCPServer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			handleToolToggle(w, r, server)
+			return
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		
-		tools := []map[string]interface{}{
-			{
-				"name": "ping",
-				"description": "简单的ping工具，用于测试MCP服务器连接和响应。返回指定的消息或默认的'pong'响应。",
-				"endpoint": "/mcp/tools/ping",
-			},
-			{
-				"name": "echo",
-				"description": "高级文本处理和格式化工具，支持大小写转换、前缀后缀添加、文本重复等功能。",
-				"endpoint": "/mcp/tools/echo",
-			},
-			{
-				"name": "system_info",
-				"description": "获取系统运行时信息，包括Go运行时、内存使用、环境变量、进程信息等。",
-				"endpoint": "/mcp/tools/system_info",
-			},
-			{
-				"name": "describe_regions",
-				"description": "查询腾讯云产品支持的地域信息。支持多种产品(如tke、cvm、cos等)，支持 JSON 和表格两种输出格式。",
-				"endpoint": "/mcp/tools/describe_regions",
-			},
-			{
-				"name": "get_region",
-				"description": "根据地域ID查询腾讯云产品特定地域的详细信息。支持多种产品(如tke、cvm、cos等)，支持 JSON 和表格两种输出格式。",
-				"endpoint": "/mcp/tools/get_region",
-			},
-			{
-				"name": "tencentcloud_validate",
-				"description": "验证腾讯云 API 连接和权限配置。检查 SecretID、SecretKey 是否正确以及相关服务权限。",
-				"endpoint": "/mcp/tools/tencentcloud_validate",
-			},
-			{
-				"name": "tke_describe_clusters",
-				"description": "查询指定地域的 TKE 集群列表。支持按集群类型过滤：all(全部)、tke(普通集群)、serverless(弹性集群)。默认查询全部集群。",
-				"endpoint": "/mcp/tools/tke_describe_clusters",
-			},
-			{
-				"name": "tke_describe_cluster_extra_args",
-				"description": "查询指定地域下指定 TKE 集群的自定义参数(Etcd、KubeAPIServer、KubeControllerManager、KubeScheduler)。",
-				"endpoint": "/mcp/tools/tke_describe_cluster_extra_args",
-			},
-			{
-				"name": "tke_get_cluster_level_price",
-				"description": "获取指定地域下指定集群等级的价格信息。集群等级可选：L20、L50、L100、L200、L500、L1000、L3000、L5000。",
-				"endpoint": "/mcp/tools/tke_get_cluster_level_price",
-			},
-			{
-				"name": "tke_describe_addon",
-				"description": "查询指定地域下指定 TKE 集群已安装的 addon 列表。可选指定 addon 名称查询特定 addon。",
-				"endpoint": "/mcp/tools/tke_describe_addon",
-			},
-			{
-				"name": "tke_get_app_chart_list",
-				"description": "获取指定地域可安装的 TKE addon 列表。支持按类型(kind)、架构(arch)、集群类型(cluster_type)过滤。",
-				"endpoint": "/mcp/tools/tke_get_app_chart_list",
-			},
-			{
-				"name": "tke_describe_images",
-				"description": "获取指定地域支持的 TKE 节点 OS 镜像列表。",
-				"endpoint": "/mcp/tools/tke_describe_images",
-			},
-			{
-				"name": "tke_describe_versions",
-				"description": "获取指定地域支持的 TKE 集群 Kubernetes 版本列表。",
-				"endpoint": "/mcp/tools/tke_describe_versions",
-			},
-			{
-				"name": "tke_describe_log_switches",
-				"description": "查询指定地域下指定 TKE 集群的日志采集开关状态，包括审计日志、事件日志、普通日志和 Master 日志。",
-				"endpoint": "/mcp/tools/tke_describe_log_switches",
-			},
-			{
-				"name": "tke_describe_master_component",
-				"description": "查询指定地域下指定 TKE 集群的 master 组件运行状态。支持 kube-apiserver、kube-scheduler、kube-controller-manager。",
-				"endpoint": "/mcp/tools/tke_describe_master_component",
-			},
-			{
-				"name": "tke_describe_cluster_instances",
-				"description": "查询指定地域下指定 TKE 集群的节点实例列表，包含节点IP、角色、状态、封锁状态、节点池等信息。",
-				"endpoint": "/mcp/tools/tke_describe_cluster_instances",
-			},
-			{
-				"name": "tke_describe_cluster_virtual_node",
-				"description": "查询指定地域下指定 TKE 集群的超级节点列表。",
-				"endpoint": "/mcp/tools/tke_describe_cluster_virtual_node",
-			},
-			{
-				"name": "cvm_describe_instances",
-				"description": "查询指定地域的 CVM 实例列表。支持按实例ID、名称、可用区等过滤。",
-				"endpoint": "/mcp/tools/cvm_describe_instances",
-			},
-			{
-				"name": "cvm_describe_instances_status",
-				"description": "查询指定地域的 CVM 实例状态列表。",
-				"endpoint": "/mcp/tools/cvm_describe_instances_status",
-			},
-			{
-				"name": "clb_describe_load_balancers",
-				"description": "查询指定地域的 CLB 负载均衡实例列表。支持按ID、名称、类型等过滤。",
-				"endpoint": "/mcp/tools/clb_describe_load_balancers",
-			},
-			{
-				"name": "clb_describe_listeners",
-				"description": "查询指定 CLB 实例的监听器列表。",
-				"endpoint": "/mcp/tools/clb_describe_listeners",
-			},
-			{
-				"name": "clb_describe_targets",
-				"description": "查询指定 CLB 实例绑定的后端目标(RS)列表。",
-				"endpoint": "/mcp/tools/clb_describe_targets",
-			},
-			{
-				"name": "clb_describe_target_health",
-				"description": "查询指定 CLB 实例后端目标的健康检查状态。",
-				"endpoint": "/mcp/tools/clb_describe_target_health",
-			},
-			{
-				"name": "cdb_describe_db_instances",
-				"description": "查询指定地域的 CDB (MySQL) 实例列表。",
-				"endpoint": "/mcp/tools/cdb_describe_db_instances",
-			},
-			{
-				"name": "cdb_describe_db_instance_info",
-				"description": "查询指定 CDB (MySQL) 实例的详细信息。",
-				"endpoint": "/mcp/tools/cdb_describe_db_instance_info",
-			},
-			{
-				"name": "cdb_describe_slow_logs",
-				"description": "查询指定 CDB (MySQL) 实例的慢查询日志文件列表。",
-				"endpoint": "/mcp/tools/cdb_describe_slow_logs",
-			},
-			{
-				"name": "cdb_describe_error_log",
-				"description": "查询指定 CDB (MySQL) 实例的错误日志数据。",
-				"endpoint": "/mcp/tools/cdb_describe_error_log",
-			},
-			{
-				"name": "vpc_describe_vpcs",
-				"description": "查询指定地域的 VPC 列表。",
-				"endpoint": "/mcp/tools/vpc_describe_vpcs",
-			},
-			{
-				"name": "vpc_describe_subnets",
-				"description": "查询指定地域的子网列表，支持按 VPC ID 过滤。",
-				"endpoint": "/mcp/tools/vpc_describe_subnets",
-			},
-			{
-				"name": "vpc_describe_security_groups",
-				"description": "查询指定地域的安全组列表。",
-				"endpoint": "/mcp/tools/vpc_describe_security_groups",
-			},
-			{
-				"name": "vpc_describe_network_interfaces",
-				"description": "查询指定地域的弹性网卡(ENI)列表，支持按 VPC ID 过滤。",
-				"endpoint": "/mcp/tools/vpc_describe_network_interfaces",
-			},
-			{
-				"name": "vpc_describe_addresses",
-				"description": "查询指定地域的弹性公网IP(EIP)列表。",
-				"endpoint": "/mcp/tools/vpc_describe_addresses",
-			},
-			{
-				"name": "vpc_describe_bandwidth_packages",
-				"description": "查询指定地域的带宽包列表。",
-				"endpoint": "/mcp/tools/vpc_describe_bandwidth_packages",
-			},
-			{
-				"name": "vpc_describe_vpc_endpoint",
-				"description": "查询指定地域的终端节点列表。",
-				"endpoint": "/mcp/tools/vpc_describe_vpc_endpoint",
-			},
-			{
-				"name": "vpc_describe_vpc_endpoint_service",
-				"description": "查询指定地域的终端节点服务列表。",
-				"endpoint": "/mcp/tools/vpc_describe_vpc_endpoint_service",
-			},
-			{
-				"name": "vpc_describe_vpc_peering_connections",
-				"description": "查询指定地域的对等连接列表。",
-				"endpoint": "/mcp/tools/vpc_describe_vpc_peering_connections",
-			},
+		// 工具描述与tools/list同源，均来自注册时生成的描述信息；被禁用的工具同样列出
+		registry := tools.GetGlobalRegistry()
+		registeredTools := registry.ListTools()
+		toolList := make([]map[string]interface{}, 0, len(registeredTools))
+		enabledCount := 0
+		for _, toolName := range registeredTools {
+			toolInfo, exists := server.GetToolInfo(toolName)
+			if !exists {
+				continue
+			}
+			enabled := registry.IsToolEnabled(toolName)
+			if enabled {
+				enabledCount++
+			}
+			toolList = append(toolList, map[string]interface{}{
+				"name":        toolName,
+				"description": toolInfo["description"],
+				"enabled":     enabled,
+				"endpoint":    restToolsPath + "/" + toolName + restCallSuffix,
+			})
 		}
 		
-		toolsJSON, _ := json.Marshal(tools)
+		toolsJSON, _ := json.Marshal(toolList)
 		
 		response := fmt.Sprintf(`{
 		"service": "ai-sre-mcp-server",
 		"timestamp": "%s",
 		"total_tools": %d,
+		"enabled_tools": %d,
 		"tools": %s,
 		"note": "These are MCP tools available for execution via the Model Context Protocol"
-	}`, time.Now().UTC().Format(time.RFC3339), len(tools), string(toolsJSON))
+	}`, time.Now().UTC().Format(time.RFC3339), len(toolList), enabledCount, string(toolsJSON))
 		
 		w.Write([]byte(response))
 	}
 }
 
+// handleToolToggle 运行时启用或禁用工具，可用工具集合变化时向所有会话推送 notifications/tools/list_changed
+func handleToolToggle(w http.ResponseWriter, r *http.Request, server *MCPServer) {
+	var request struct {
+		Name    string `json:"name"`
+		Enabled *bool  `json:"enabled"`
+	}
+	if err := json.NewDecoder(io.LimitReader(r.Body, 64*1024)).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.Name == "" || request.Enabled == nil {
+		http.Error(w, "Both name and enabled are required", http.StatusBadRequest)
+		return
+	}
+	if _, exists := server.GetToolInfo(request.Name); !exists {
+		http.Error(w, "Tool not found", http.StatusNotFound)
+		return
+	}
+
+	changed, err := tools.GetGlobalRegistry().SetToolEnabled(request.Name, *request.Enabled)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"tool_name":   request.Name,
+		"enabled":     *request.Enabled,
+		"changed":     changed,
+		"remote_addr": r.RemoteAddr,
+	}).Info("Tool toggled via management endpoint")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"name":    request.Name,
+		"enabled": *request.Enabled,
+		"changed": changed,
+	})
+}
+
+// responseWriteMargin 请求超时之后写出响应预留的时间
+const responseWriteMargin = 10 * time.Second
+
+// extendWriteDeadline 将响应的写超时延长到请求超时之后，requestTimeout 为 0 时取消写超时
+// 服务器级别的写超时从读取请求头开始计算，不大于请求超时时耗时较长的调用在写出响应前连接就已被关闭
+func extendWriteDeadline(w http.ResponseWriter, requestTimeout time.Duration) {
+	var deadline time.Time
+	if requestTimeout > 0 {
+		deadline = time.Now().Add(requestTimeout + responseWriteMargin)
+	}
+	if err := http.NewResponseController(w).SetWriteDeadline(deadline); err != nil {
+		logger.WithError(err).Debug("Failed to extend write deadline for request")
+	}
+}
+
 // handleMCPRequest 处理MCP协议请求
 func handleMCPRequest(w http.ResponseWriter, r *http.Request, handler *transport.MCPMessageHandler) {
 	// 验证协议版本
@@ -847,19 +890,14 @@ func handleMCPRequest(w http.ResponseWriter, r *http.Request, handler *transport
 	}
 	
 	// 验证支持的版本
-	supportedVersions := []string{"2024-11-05", "2025-03-26", "2025-06-18"}
-	supported := false
-	for _, v := range supportedVersions {
-		if v == protocolVersion {
-			supported = true
-			break
-		}
-	}
-	if !supported {
+	if !transport.IsSupportedProtocolVersion(protocolVersion) {
 		http.Error(w, "Unsupported protocol version", http.StatusBadRequest)
 		return
 	}
 
+	sessions := handler.Sessions()
+	sessionID := r.Header.Get(transport.SessionHeader)
+
 	switch r.Method {
 	case http.MethodPost:
 		// 读取请求体
@@ -875,13 +913,54 @@ func handleMCPRequest(w http.ResponseWriter, r *http.Request, handler *transport
 			return
 		}
 
+		// 查找会话，initialize 请求在没有会话ID时创建新会话
+		var session *transport.Session
+		created := false
+		if sessionID != "" {
+			var exists bool
+			session, exists = sessions.Get(sessionID)
+			if !exists {
+				http.Error(w, "Session not found", http.StatusNotFound)
+				return
+			}
+		} else if isInitializeRequest(body) {
+			var err error
+			session, err = sessions.Create(remoteHost(r))
+			if err != nil {
+				writeSessionLimitError(w, err, requestID(body))
+				return
+			}
+			created = true
+		} else {
+			http.Error(w, "Missing "+transport.SessionHeader+" header", http.StatusBadRequest)
+			return
+		}
+
+		// 工具调用可能持续到请求超时，按请求超时延长写超时，保证超时错误能够写回客户端
+		extendWriteDeadline(w, handler.RequestTimeout())
+
 		// 处理MCP消息
-		response, err := handler.HandleMessage(r.Context(), body)
+		ctx := caller.WithIdentity(transport.WithSession(r.Context(), session), remoteHost(r))
+		response, err := handler.HandleMessage(ctx, body)
+		if created {
+			if err == nil && session.IsInitialized() {
+				w.Header().Set(transport.SessionHeader, session.ID)
+			} else {
+				// 初始化失败的会话不保留
+				sessions.Delete(session.ID)
+			}
+		}
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
+		// 通知消息没有响应内容
+		if response == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
 		// 检查Accept头决定响应格式
 		accept := r.Header.Get("Accept")
 		if strings.Contains(accept, "text/event-stream") {
@@ -901,20 +980,77 @@ func handleMCPRequest(w http.ResponseWriter, r *http.Request, handler *transport
 		}
 
 	case http.MethodGet:
-		// 建立SSE流用于接收服务器推送的消息
-		w.Header().Set("Content-Type", "text/event-stream")
-		w.Header().Set("Cache-Control", "no-cache")
-		w.Header().Set("Connection", "keep-alive")
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-
-		// 保持连接开放
-		select {
-		case <-r.Context().Done():
+		if sessionID == "" {
+			http.Error(w, "Missing "+transport.SessionHeader+" header", http.StatusBadRequest)
+			return
+		}
+		session, exists := sessions.Get(sessionID)
+		if !exists {
+			http.Error(w, "Session not found", http.StatusNotFound)
 			return
 		}
 
+		// 建立SSE流推送服务器发起的消息，支持 Last-Event-ID 断线补发
+		serveEventStream(w, r, session, r.Header.Get("Last-Event-ID"), nil)
+
+	case http.MethodDelete:
+		// 客户端主动结束会话
+		if sessionID == "" {
+			http.Error(w, "Missing "+transport.SessionHeader+" header", http.StatusBadRequest)
+			return
+		}
+		if !sessions.Delete(sessionID) {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		
+		logger.WithFields(logrus.Fields{
+			"session_id": sessionID,
+		}).Info("Session terminated by client")
+		w.WriteHeader(http.StatusNoContent)
+
 	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
+// writeSessionLimitError 会话数达到上限时返回 JSON-RPC 错误
+// 单个客户端的会话数达到上限返回 429，会话总数达到上限返回 503
+func writeSessionLimitError(w http.ResponseWriter, err error, id interface{}) {
+	limitErr, ok := err.(*transport.SessionLimitError)
+	if !ok {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status := http.StatusServiceUnavailable
+	if limitErr.Reason == "max_sessions_per_client" {
+		status = http.StatusTooManyRequests
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(limitErr.ErrorResponse(id))
+}
+
+// requestID 获取请求体中的JSON-RPC请求ID，没有或无法解析时返回 nil
+func requestID(body []byte) interface{} {
+	var request struct {
+		ID interface{} `json:"id"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil
+	}
+	return request.ID
+}
+
+// isInitializeRequest 判断请求体是否为initialize请求
+func isInitializeRequest(body []byte) bool {
+	var request struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return false
+	}
+	return request.Method == "initialize"
+}
+
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/transport"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// sseKeepAliveInterval SSE流保活间隔
+const sseKeepAliveInterval = 15 * time.Second
+
+// serveEventStream 将会话事件流以SSE格式推送给客户端，直到客户端断开或会话结束
+// onOpen 在响应头发送后、补发事件前调用，用于发送传输层自身的首个事件
+func serveEventStream(w http.ResponseWriter, r *http.Request, session *transport.Session, lastEventID string, onOpen func(w http.ResponseWriter) error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// SSE是长连接，取消服务器级别的写超时
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		logger.WithError(err).Debug("Failed to clear write deadline for event stream")
+	}
+
+	replay, events, cancel := session.Events().Subscribe(lastEventID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+
+	if onOpen != nil {
+		if err := onOpen(w); err != nil {
+			return
+		}
+	}
+
+	for _, event := range replay {
+		if err := writeSSEEvent(w, event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	logger.WithFields(logrus.Fields{
+		"session_id":      session.ID,
+		"last_event_id":   lastEventID,
+		"replayed_events": len(replay),
+	}).Debug("Event stream opened")
+
+	ticker := time.NewTicker(sseKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// 会话结束或订阅被替换
+				return
+			}
+			if err := writeSSEEvent(w, event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ticker.C:
+			// 保活注释，同时刷新会话活跃时间，避免持有推送流的会话过期
+			session.Touch()
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeSSEEvent 写出一条带ID的SSE消息事件
+func writeSSEEvent(w http.ResponseWriter, event transport.Event) error {
+	_, err := fmt.Fprintf(w, "id: %s\nevent: message\ndata: %s\n\n", event.ID, event.Data)
+	return err
+}
+
+// legacySSEMessagesPath 旧版SSE传输 (2024-11-05) 的消息提交端点
+const legacySSEMessagesPath = "/messages"
+
+// legacySSESendTimeout 旧版SSE事件流消费过慢时等待投递的时间，超时仍未送达才断开连接
+const legacySSESendTimeout = 10 * time.Second
+
+// legacyMaxInflightPerSession 旧版SSE传输单个会话同时处理的消息数上限，超出时消息提交直接返回 429
+const legacyMaxInflightPerSession = 16
+
+// legacyInflight 旧版SSE会话的消息处理名额，按会话ID登记，事件流连接断开时移除
+var legacyInflight sync.Map
+
+// handleLegacySSE 处理旧版SSE传输的事件流连接 (GET /sse)
+// 每个连接对应一个会话，首个事件为 endpoint，告知客户端提交消息的地址；连接断开时会话随之结束
+func handleLegacySSE(w http.ResponseWriter, r *http.Request, handler *transport.MCPMessageHandler) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessions := handler.Sessions()
+	session, err := sessions.Create(remoteHost(r))
+	if err != nil {
+		writeSessionLimitError(w, err, nil)
+		return
+	}
+	defer sessions.Delete(session.ID)
+
+	legacyInflight.Store(session.ID, make(chan struct{}, legacyMaxInflightPerSession))
+	defer legacyInflight.Delete(session.ID)
+
+	// 旧版SSE传输无法断线补发，事件流断开即丢失会话和未送达的响应；客户端消费过慢时发布者等待而不是立即断开
+	session.Events().SetSendTimeout(legacySSESendTimeout)
+
+	logger.WithFields(logrus.Fields{
+		"session_id":  session.ID,
+		"remote_addr": r.RemoteAddr,
+	}).Info("Legacy SSE connection established")
+
+	endpoint := fmt.Sprintf("%s?sessionId=%s", legacySSEMessagesPath, session.ID)
+	serveEventStream(w, r, session, "", func(w http.ResponseWriter) error {
+		_, err := fmt.Fprintf(w, "event: endpoint\ndata: %s\n\n", endpoint)
+		return err
+	})
+
+	logger.WithFields(logrus.Fields{
+		"session_id": session.ID,
+	}).Info("Legacy SSE connection closed")
+}
+
+// handleLegacyMessages 处理旧版SSE传输的消息提交 (POST /messages?sessionId=...)
+// 请求被接受后立即返回 202，响应通过会话的事件流异步发送；会话同时处理的消息达到上限时返回 429
+func handleLegacyMessages(w http.ResponseWriter, r *http.Request, handler *transport.MCPMessageHandler) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := r.URL.Query().Get("sessionId")
+	if sessionID == "" {
+		http.Error(w, "Missing sessionId parameter", http.StatusBadRequest)
+		return
+	}
+
+	session, exists := handler.Sessions().Get(sessionID)
+	slots, legacy := legacyInflight.Load(sessionID)
+	if !exists || !legacy {
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	}
+	inflight := slots.(chan struct{})
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	if len(body) == 0 {
+		http.Error(w, "Empty request body", http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case inflight <- struct{}{}:
+	default:
+		writeLegacyBusyError(w, requestID(body))
+		return
+	}
+
+	client := remoteHost(r)
+
+	// 消息在后台处理，生命周期与事件流连接绑定：SSE连接断开时进行中的请求随会话一起取消
+	go func() {
+		defer func() { <-inflight }()
+
+		ctx := caller.WithIdentity(transport.WithSession(session.Context(), session), client)
+		response, err := handler.HandleMessage(ctx, body)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"session_id": session.ID,
+				"error":      err.Error(),
+			}).Error("Failed to handle legacy SSE message")
+			return
+		}
+		if response == nil {
+			return
+		}
+
+		if _, err := session.Events().Publish(response); err != nil {
+			logger.WithFields(logrus.Fields{
+				"session_id": session.ID,
+				"error":      err.Error(),
+			}).Warn("Failed to deliver response on legacy SSE stream")
+		}
+	}()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// writeLegacyBusyError 会话同时处理的消息达到上限时返回 JSON-RPC 错误
+func writeLegacyBusyError(w http.ResponseWriter, id interface{}) {
+	response, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": map[string]interface{}{
+			"code":    -32000,
+			"message": "Server busy",
+			"data": map[string]interface{}{
+				"reason": "session_inflight_full",
+				"limit":  legacyMaxInflightPerSession,
+			},
+		},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", "1")
+	w.WriteHeader(http.StatusTooManyRequests)
+	w.Write(response)
+}
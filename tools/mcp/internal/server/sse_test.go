@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ai-sre/tools/mcp/internal/transport"
+)
+
+func TestLegacyMessagesRejectsWhenSessionSaturated(t *testing.T) {
+	handler := transport.NewMCPMessageHandler(nil)
+	session, err := handler.Sessions().Create("10.0.0.1")
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+
+	// 名额已被占满的会话
+	inflight := make(chan struct{}, legacyMaxInflightPerSession)
+	for i := 0; i < legacyMaxInflightPerSession; i++ {
+		inflight <- struct{}{}
+	}
+	legacyInflight.Store(session.ID, inflight)
+	defer legacyInflight.Delete(session.ID)
+
+	body := `{"jsonrpc":"2.0","id":3,"method":"tools/list"}`
+	request := httptest.NewRequest(http.MethodPost, legacySSEMessagesPath+"?sessionId="+session.ID, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handleLegacyMessages(recorder, request, handler)
+
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusTooManyRequests)
+	}
+	if !strings.Contains(recorder.Body.String(), `"session_inflight_full"`) || !strings.Contains(recorder.Body.String(), `"id":3`) {
+		t.Fatalf("body = %s", recorder.Body.String())
+	}
+}
+
+func TestLegacyMessagesRequiresLegacySession(t *testing.T) {
+	handler := transport.NewMCPMessageHandler(nil)
+	session, err := handler.Sessions().Create("10.0.0.1")
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+
+	// /mcp 创建的会话没有旧版SSE事件流，不能通过 /messages 提交
+	request := httptest.NewRequest(http.MethodPost, legacySSEMessagesPath+"?sessionId="+session.ID, strings.NewReader(`{}`))
+	recorder := httptest.NewRecorder()
+	handleLegacyMessages(recorder, request, handler)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
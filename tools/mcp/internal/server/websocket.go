@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/config"
+	"ai-sre/tools/mcp/internal/transport"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// websocketPath WebSocket 传输的 MCP 端点
+const websocketPath = "/mcp/ws"
+
+// websocketHandler 处理 WebSocket 传输的连接 (GET /mcp/ws)
+// 每个连接对应一个会话，连接期间的请求、响应和服务器推送的通知都在同一连接上收发；
+// 只接受同主机或 MCP_WS_ALLOWED_ORIGINS 中的浏览器来源；
+// 接管后的连接不受 HTTP 服务器关闭的影响，ctx 取消时主动关闭
+func websocketHandler(ctx context.Context, cfg *config.Config, handler *transport.MCPMessageHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 升级前创建会话，会话数达到上限时直接以 HTTP 响应拒绝
+		session, err := handler.Sessions().Create(remoteHost(r))
+		if err != nil {
+			writeSessionLimitError(w, err, nil)
+			return
+		}
+
+		conn, err := transport.UpgradeWebSocket(w, r, cfg.MCP.WebSocketAllowedOrigins)
+		if err != nil {
+			handler.Sessions().Delete(session.ID)
+			logger.WithFields(logrus.Fields{
+				"remote_addr": r.RemoteAddr,
+				"error":       err.Error(),
+			}).Warn("WebSocket upgrade failed")
+			return
+		}
+
+		ws := transport.NewWebSocketTransport(handler, conn, session, cfg.MCP.WebSocketPingInterval)
+		logger.WithFields(logrus.Fields{
+			"session_id":  ws.Session().ID,
+			"remote_addr": r.RemoteAddr,
+		}).Info("WebSocket connection established")
+
+		if err := ws.Serve(caller.WithIdentity(ctx, remoteHost(r))); err != nil {
+			logger.WithFields(logrus.Fields{
+				"session_id": ws.Session().ID,
+				"error":      err.Error(),
+			}).Warn("WebSocket connection failed")
+		}
+
+		logger.WithFields(logrus.Fields{
+			"session_id": ws.Session().ID,
+		}).Info("WebSocket connection closed")
+	}
+}
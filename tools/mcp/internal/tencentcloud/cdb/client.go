@@ -125,7 +125,7 @@ type DescribeDBInstancesResult struct {
 
 // DescribeDBInstances 查询 CDB 实例列表
 func (c *Client) DescribeDBInstances(ctx context.Context, region string) (*DescribeDBInstancesResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region": region,
 	}).Debug("开始查询 CDB 实例列表")
 
@@ -140,10 +140,10 @@ func (c *Client) DescribeDBInstances(ctx context.Context, region string) (*Descr
 	var limit uint64 = 2000
 	request.Limit = &limit
 
-	response, err := client.DescribeDBInstances(request)
+	response, err := client.DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CDB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CDB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CDB 实例列表失败: %w", err)
 	}
@@ -182,7 +182,7 @@ func (c *Client) DescribeDBInstances(ctx context.Context, region string) (*Descr
 		result.Instances = append(result.Instances, info)
 	}
 
-	c.logger.WithField("instance_count", len(result.Instances)).Info("成功查询 CDB 实例列表")
+	c.logger.WithContext(ctx).WithField("instance_count", len(result.Instances)).Info("成功查询 CDB 实例列表")
 	return result, nil
 }
 
@@ -253,7 +253,7 @@ type DBInstanceDetailInfo struct {
 
 // DescribeDBInstanceInfo 查询 CDB 实例详细信息
 func (c *Client) DescribeDBInstanceInfo(ctx context.Context, region string, instanceId string) (*DBInstanceDetailInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"instance_id": instanceId,
 	}).Debug("开始查询 CDB 实例详细信息")
@@ -268,10 +268,10 @@ func (c *Client) DescribeDBInstanceInfo(ctx context.Context, region string, inst
 	request := cdb.NewDescribeDBInstanceInfoRequest()
 	request.InstanceId = &instanceId
 
-	response, err := client.DescribeDBInstanceInfo(request)
+	response, err := client.DescribeDBInstanceInfoWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CDB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CDB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CDB 实例详细信息失败: %w", err)
 	}
@@ -285,7 +285,7 @@ func (c *Client) DescribeDBInstanceInfo(ctx context.Context, region string, inst
 		DefaultKmsRegion: getStringValue(response.Response.DefaultKmsRegion),
 	}
 
-	c.logger.WithField("instance_id", instanceId).Info("成功查询 CDB 实例详细信息")
+	c.logger.WithContext(ctx).WithField("instance_id", instanceId).Info("成功查询 CDB 实例详细信息")
 	return result, nil
 }
 
@@ -334,7 +334,7 @@ type DescribeSlowLogsResult struct {
 
 // DescribeSlowLogs 查询 CDB 慢日志列表
 func (c *Client) DescribeSlowLogs(ctx context.Context, region string, instanceId string) (*DescribeSlowLogsResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"instance_id": instanceId,
 	}).Debug("开始查询 CDB 慢日志列表")
@@ -351,10 +351,10 @@ func (c *Client) DescribeSlowLogs(ctx context.Context, region string, instanceId
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeSlowLogs(request)
+	response, err := client.DescribeSlowLogsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CDB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CDB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CDB 慢日志失败: %w", err)
 	}
@@ -377,7 +377,7 @@ func (c *Client) DescribeSlowLogs(ctx context.Context, region string, instanceId
 		result.Items = append(result.Items, info)
 	}
 
-	c.logger.WithField("log_count", len(result.Items)).Info("成功查询 CDB 慢日志列表")
+	c.logger.WithContext(ctx).WithField("log_count", len(result.Items)).Info("成功查询 CDB 慢日志列表")
 	return result, nil
 }
 
@@ -430,7 +430,7 @@ type DescribeErrorLogDataResult struct {
 
 // DescribeErrorLogData 查询 CDB 错误日志
 func (c *Client) DescribeErrorLogData(ctx context.Context, region string, instanceId string, startTime, endTime uint64) (*DescribeErrorLogDataResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"instance_id": instanceId,
 		"start_time":  startTime,
@@ -451,10 +451,10 @@ func (c *Client) DescribeErrorLogData(ctx context.Context, region string, instan
 	var limit int64 = 400
 	request.Limit = &limit
 
-	response, err := client.DescribeErrorLogData(request)
+	response, err := client.DescribeErrorLogDataWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CDB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CDB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CDB 错误日志失败: %w", err)
 	}
@@ -475,7 +475,7 @@ func (c *Client) DescribeErrorLogData(ctx context.Context, region string, instan
 		result.Items = append(result.Items, info)
 	}
 
-	c.logger.WithField("log_count", len(result.Items)).Info("成功查询 CDB 错误日志")
+	c.logger.WithContext(ctx).WithField("log_count", len(result.Items)).Info("成功查询 CDB 错误日志")
 	return result, nil
 }
 
@@ -128,7 +128,7 @@ type DescribeLoadBalancersResult struct {
 
 // DescribeLoadBalancers 查询 CLB 实例列表
 func (c *Client) DescribeLoadBalancers(ctx context.Context, region string) (*DescribeLoadBalancersResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region": region,
 	}).Debug("开始查询 CLB 实例列表")
 
@@ -143,10 +143,10 @@ func (c *Client) DescribeLoadBalancers(ctx context.Context, region string) (*Des
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeLoadBalancers(request)
+	response, err := client.DescribeLoadBalancersWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CLB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CLB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CLB 实例列表失败: %w", err)
 	}
@@ -172,7 +172,7 @@ func (c *Client) DescribeLoadBalancers(ctx context.Context, region string) (*Des
 		result.LoadBalancers = append(result.LoadBalancers, info)
 	}
 
-	c.logger.WithField("lb_count", len(result.LoadBalancers)).Info("成功查询 CLB 实例列表")
+	c.logger.WithContext(ctx).WithField("lb_count", len(result.LoadBalancers)).Info("成功查询 CLB 实例列表")
 	return result, nil
 }
 
@@ -244,7 +244,7 @@ type DescribeListenersResult struct {
 
 // DescribeListeners 查询 CLB 监听器列表
 func (c *Client) DescribeListeners(ctx context.Context, region string, loadBalancerId string) (*DescribeListenersResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":           region,
 		"load_balancer_id": loadBalancerId,
 	}).Debug("开始查询 CLB 监听器列表")
@@ -259,10 +259,10 @@ func (c *Client) DescribeListeners(ctx context.Context, region string, loadBalan
 	request := clb.NewDescribeListenersRequest()
 	request.LoadBalancerId = &loadBalancerId
 
-	response, err := client.DescribeListeners(request)
+	response, err := client.DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CLB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CLB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CLB 监听器列表失败: %w", err)
 	}
@@ -287,7 +287,7 @@ func (c *Client) DescribeListeners(ctx context.Context, region string, loadBalan
 		result.Listeners = append(result.Listeners, info)
 	}
 
-	c.logger.WithField("listener_count", len(result.Listeners)).Info("成功查询 CLB 监听器列表")
+	c.logger.WithContext(ctx).WithField("listener_count", len(result.Listeners)).Info("成功查询 CLB 监听器列表")
 	return result, nil
 }
 
@@ -353,7 +353,7 @@ type DescribeTargetsResult struct {
 
 // DescribeTargets 查询 CLB 后端服务列表
 func (c *Client) DescribeTargets(ctx context.Context, region string, loadBalancerId string, listenerIds []string) (*DescribeTargetsResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":           region,
 		"load_balancer_id": loadBalancerId,
 	}).Debug("开始查询 CLB 后端服务列表")
@@ -374,10 +374,10 @@ func (c *Client) DescribeTargets(ctx context.Context, region string, loadBalance
 		}
 	}
 
-	response, err := client.DescribeTargets(request)
+	response, err := client.DescribeTargetsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CLB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CLB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CLB 后端服务失败: %w", err)
 	}
@@ -408,7 +408,7 @@ func (c *Client) DescribeTargets(ctx context.Context, region string, loadBalance
 		result.Listeners = append(result.Listeners, lbInfo)
 	}
 
-	c.logger.WithField("listener_count", len(result.Listeners)).Info("成功查询 CLB 后端服务列表")
+	c.logger.WithContext(ctx).WithField("listener_count", len(result.Listeners)).Info("成功查询 CLB 后端服务列表")
 	return result, nil
 }
 
@@ -486,7 +486,7 @@ type DescribeTargetHealthResult struct {
 
 // DescribeTargetHealth 查询后端健康状态
 func (c *Client) DescribeTargetHealth(ctx context.Context, region string, loadBalancerIds []string) (*DescribeTargetHealthResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":            region,
 		"load_balancer_ids": loadBalancerIds,
 	}).Debug("开始查询 CLB 后端健康状态")
@@ -504,10 +504,10 @@ func (c *Client) DescribeTargetHealth(ctx context.Context, region string, loadBa
 		request.LoadBalancerIds = append(request.LoadBalancerIds, &idCopy)
 	}
 
-	response, err := client.DescribeTargetHealth(request)
+	response, err := client.DescribeTargetHealthWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CLB API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CLB", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CLB 后端健康状态失败: %w", err)
 	}
@@ -546,7 +546,7 @@ func (c *Client) DescribeTargetHealth(ctx context.Context, region string, loadBa
 		result.LoadBalancers = append(result.LoadBalancers, lbHealth)
 	}
 
-	c.logger.WithField("lb_count", len(result.LoadBalancers)).Info("成功查询 CLB 后端健康状态")
+	c.logger.WithContext(ctx).WithField("lb_count", len(result.LoadBalancers)).Info("成功查询 CLB 后端健康状态")
 	return result, nil
 }
 
@@ -56,6 +56,16 @@ func (cm *ClientManager) GetCredential() *common.Credential {
 	return common.NewCredential(cm.config.SecretID, cm.config.SecretKey)
 }
 
+// GetDefaultRegion 获取配置的默认地域
+func (cm *ClientManager) GetDefaultRegion() string {
+	return cm.config.Region
+}
+
+// UseInternal 是否使用内网访问
+func (cm *ClientManager) UseInternal() bool {
+	return cm.config.UseInternal
+}
+
 // GetClientProfile 获取客户端配置
 // product 为产品标识（如 tke、cvm、clb、cdb、vpc），用于生成对应的 API 域名
 func (cm *ClientManager) GetClientProfile(product string) *profile.ClientProfile {
@@ -120,7 +120,7 @@ type DescribeInstancesResult struct {
 
 // DescribeInstances 查询 CVM 实例列表
 func (c *Client) DescribeInstances(ctx context.Context, region string) (*DescribeInstancesResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region": region,
 	}).Debug("开始查询 CVM 实例列表")
 
@@ -135,10 +135,10 @@ func (c *Client) DescribeInstances(ctx context.Context, region string) (*Describ
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeInstances(request)
+	response, err := client.DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CVM API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CVM", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CVM 实例列表失败: %w", err)
 	}
@@ -174,7 +174,7 @@ func (c *Client) DescribeInstances(ctx context.Context, region string) (*Describ
 		result.Instances = append(result.Instances, info)
 	}
 
-	c.logger.WithField("instance_count", len(result.Instances)).Info("成功查询 CVM 实例列表")
+	c.logger.WithContext(ctx).WithField("instance_count", len(result.Instances)).Info("成功查询 CVM 实例列表")
 	return result, nil
 }
 
@@ -237,7 +237,7 @@ type DescribeInstancesStatusResult struct {
 
 // DescribeInstancesStatus 查询 CVM 实例状态列表
 func (c *Client) DescribeInstancesStatus(ctx context.Context, region string) (*DescribeInstancesStatusResult, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region": region,
 	}).Debug("开始查询 CVM 实例状态列表")
 
@@ -252,10 +252,10 @@ func (c *Client) DescribeInstancesStatus(ctx context.Context, region string) (*D
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeInstancesStatus(request)
+	response, err := client.DescribeInstancesStatusWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("CVM API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("CVM", sdkError)
 		}
 		return nil, fmt.Errorf("查询 CVM 实例状态失败: %w", err)
 	}
@@ -272,7 +272,7 @@ func (c *Client) DescribeInstancesStatus(ctx context.Context, region string) (*D
 		})
 	}
 
-	c.logger.WithField("instance_count", len(result.Instances)).Info("成功查询 CVM 实例状态列表")
+	c.logger.WithContext(ctx).WithField("instance_count", len(result.Instances)).Info("成功查询 CVM 实例状态列表")
 	return result, nil
 }
 
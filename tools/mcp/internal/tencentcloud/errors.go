@@ -0,0 +1,31 @@
+package tencentcloud
+
+import (
+	"fmt"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+// APIError 腾讯云 API 调用错误
+// 保留 SDK 返回的错误码和请求ID，供上层按错误码区分失败原因
+type APIError struct {
+	Service   string
+	Code      string
+	Message   string
+	RequestID string
+}
+
+// NewAPIError 根据 SDK 错误创建 API 错误
+func NewAPIError(service string, sdkError *errors.TencentCloudSDKError) *APIError {
+	return &APIError{
+		Service:   service,
+		Code:      sdkError.Code,
+		Message:   sdkError.Message,
+		RequestID: sdkError.RequestId,
+	}
+}
+
+// Error 实现 error 接口
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s API 错误 [%s]: %s", e.Service, e.Code, e.Message)
+}
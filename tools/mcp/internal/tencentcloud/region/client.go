@@ -56,7 +56,7 @@ type RegionInfo struct {
 
 // DescribeRegions 查询产品支持的地域信息（使用 CVM 的 DescribeRegions）
 func (c *Client) DescribeRegions(ctx context.Context, product string) ([]RegionInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"product": product,
 	}).Debug("开始查询产品支持的地域信息")
 	
@@ -64,17 +64,17 @@ func (c *Client) DescribeRegions(ctx context.Context, product string) ([]RegionI
 	request := cvm.NewDescribeRegionsRequest()
 	
 	// 发送请求
-	response, err := c.client.DescribeRegions(request)
+	response, err := c.client.DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		// 处理腾讯云 SDK 错误
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"product":    product,
 			}).Error("地域管理 API 调用失败")
-			return nil, fmt.Errorf("地域管理 API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("地域管理", sdkError)
 		}
 		return nil, fmt.Errorf("查询产品 %s 地域信息失败: %w", product, err)
 	}
@@ -90,7 +90,7 @@ func (c *Client) DescribeRegions(ctx context.Context, product string) ([]RegionI
 		regions = append(regions, regionInfo)
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"product":      product,
 		"region_count": len(regions),
 	}).Info("成功查询产品地域信息")
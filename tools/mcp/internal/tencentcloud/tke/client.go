@@ -9,6 +9,7 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/sirupsen/logrus"
 	
+	"ai-sre/tools/mcp/internal/progress"
 	"ai-sre/tools/mcp/internal/tencentcloud"
 )
 
@@ -66,22 +67,22 @@ type RegionInfo struct {
 
 // DescribeRegions 查询 TKE 支持的地域信息
 func (c *Client) DescribeRegions(ctx context.Context) ([]RegionInfo, error) {
-	c.logger.Debug("开始查询 TKE 支持的地域信息")
+	c.logger.WithContext(ctx).Debug("开始查询 TKE 支持的地域信息")
 	
 	// 创建请求
 	request := tke.NewDescribeRegionsRequest()
 	
 	// 发送请求
-	response, err := c.client.DescribeRegions(request)
+	response, err := c.client.DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		// 处理腾讯云 SDK 错误
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":    sdkError.Code,
 				"message": sdkError.Message,
 				"request_id": sdkError.RequestId,
 			}).Error("TKE API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询 TKE 地域信息失败: %w", err)
 	}
@@ -97,7 +98,7 @@ func (c *Client) DescribeRegions(ctx context.Context) ([]RegionInfo, error) {
 		regions = append(regions, regionInfo)
 	}
 	
-	c.logger.WithField("region_count", len(regions)).Info("成功查询 TKE 地域信息")
+	c.logger.WithContext(ctx).WithField("region_count", len(regions)).Info("成功查询 TKE 地域信息")
 	return regions, nil
 }
 
@@ -190,7 +191,7 @@ type EKSClusterInfo struct {
 
 // DescribeClusters 查询 TKE 集群列表
 func (c *Client) DescribeClusters(ctx context.Context, region string) ([]ClusterInfo, error) {
-	c.logger.WithField("region", region).Debug("开始查询 TKE 集群列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询 TKE 集群列表")
 	
 	// 创建指定地域的客户端
 	credential := c.manager.GetCredential()
@@ -205,17 +206,17 @@ func (c *Client) DescribeClusters(ctx context.Context, region string) ([]Cluster
 	request := tke.NewDescribeClustersRequest()
 	
 	// 发送请求
-	response, err := client.DescribeClusters(request)
+	response, err := client.DescribeClustersWithContext(ctx, request)
 	if err != nil {
 		// 处理腾讯云 SDK 错误
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 			}).Error("TKE API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询 TKE 集群列表失败: %w", err)
 	}
@@ -246,7 +247,7 @@ func (c *Client) DescribeClusters(ctx context.Context, region string) ([]Cluster
 		clusters = append(clusters, clusterInfo)
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"cluster_count": len(clusters),
 	}).Info("成功查询 TKE 集群列表")
@@ -295,7 +296,7 @@ func (c *Client) FormatClustersAsTable(clusters []ClusterInfo, region string) st
 
 // DescribeEKSClusters 查询 EKS Serverless 集群列表
 func (c *Client) DescribeEKSClusters(ctx context.Context, region string) ([]EKSClusterInfo, error) {
-	c.logger.WithField("region", region).Debug("开始查询 EKS Serverless 集群列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询 EKS Serverless 集群列表")
 	
 	// 创建指定地域的客户端
 	credential := c.manager.GetCredential()
@@ -310,16 +311,16 @@ func (c *Client) DescribeEKSClusters(ctx context.Context, region string) ([]EKSC
 	request := tke.NewDescribeEKSClustersRequest()
 	
 	// 发送请求
-	response, err := client.DescribeEKSClusters(request)
+	response, err := client.DescribeEKSClustersWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 			}).Error("EKS API 调用失败")
-			return nil, fmt.Errorf("EKS API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("EKS", sdkError)
 		}
 		return nil, fmt.Errorf("查询 EKS Serverless 集群列表失败: %w", err)
 	}
@@ -353,7 +354,7 @@ func (c *Client) DescribeEKSClusters(ctx context.Context, region string) ([]EKSC
 		}
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"cluster_count": len(clusters),
 	}).Info("成功查询 EKS Serverless 集群列表")
@@ -411,7 +412,7 @@ type ClusterExtraArgsInfo struct {
 
 // DescribeClusterExtraArgs 查询集群自定义参数
 func (c *Client) DescribeClusterExtraArgs(ctx context.Context, region string, clusterID string) (*ClusterExtraArgsInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 	}).Debug("开始查询集群自定义参数")
@@ -430,17 +431,17 @@ func (c *Client) DescribeClusterExtraArgs(ctx context.Context, region string, cl
 	request.ClusterId = &clusterID
 	
 	// 发送请求
-	response, err := client.DescribeClusterExtraArgs(request)
+	response, err := client.DescribeClusterExtraArgsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 				"cluster_id": clusterID,
 			}).Error("查询集群自定义参数 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询集群自定义参数失败: %w", err)
 	}
@@ -460,7 +461,7 @@ func (c *Client) DescribeClusterExtraArgs(ctx context.Context, region string, cl
 		info.HasExtraArgs = len(info.Etcd) > 0 || len(info.KubeAPIServer) > 0 || len(info.KubeControllerManager) > 0 || len(info.KubeScheduler) > 0
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":         region,
 		"cluster_id":     clusterID,
 		"has_extra_args": info.HasExtraArgs,
@@ -536,7 +537,7 @@ type ClusterLevelPriceInfo struct {
 
 // GetClusterLevelPrice 获取集群等级价格
 func (c *Client) GetClusterLevelPrice(ctx context.Context, region string, clusterLevel string) (*ClusterLevelPriceInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"cluster_level": clusterLevel,
 	}).Debug("开始查询集群等级价格")
@@ -552,17 +553,17 @@ func (c *Client) GetClusterLevelPrice(ctx context.Context, region string, cluste
 	request := tke.NewGetClusterLevelPriceRequest()
 	request.ClusterLevel = &clusterLevel
 	
-	response, err := client.GetClusterLevelPrice(request)
+	response, err := client.GetClusterLevelPriceWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":          sdkError.Code,
 				"message":       sdkError.Message,
 				"request_id":    sdkError.RequestId,
 				"region":        region,
 				"cluster_level": clusterLevel,
 			}).Error("获取集群等级价格 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("获取集群等级价格失败: %w", err)
 	}
@@ -586,7 +587,7 @@ func (c *Client) GetClusterLevelPrice(ctx context.Context, region string, cluste
 		}
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"cluster_level": clusterLevel,
 		"cost":          info.Cost,
@@ -632,7 +633,7 @@ type ClusterAddonListInfo struct {
 
 // DescribeAddon 查询集群已安装的 addon 列表
 func (c *Client) DescribeAddon(ctx context.Context, region string, clusterID string, addonName string) (*ClusterAddonListInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 		"addon_name": addonName,
@@ -652,17 +653,17 @@ func (c *Client) DescribeAddon(ctx context.Context, region string, clusterID str
 		request.AddonName = &addonName
 	}
 	
-	response, err := client.DescribeAddon(request)
+	response, err := client.DescribeAddonWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 				"cluster_id": clusterID,
 			}).Error("查询集群 addon 列表 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询集群 addon 列表失败: %w", err)
 	}
@@ -685,7 +686,7 @@ func (c *Client) DescribeAddon(ctx context.Context, region string, clusterID str
 	}
 	info.AddonCount = len(info.Addons)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"cluster_id":  clusterID,
 		"addon_count": info.AddonCount,
@@ -751,7 +752,7 @@ type AppChartListInfo struct {
 
 // GetTkeAppChartList 获取可安装的 addon 列表
 func (c *Client) GetTkeAppChartList(ctx context.Context, region string, kind string, arch string, clusterType string) (*AppChartListInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":       region,
 		"kind":         kind,
 		"arch":         arch,
@@ -777,16 +778,16 @@ func (c *Client) GetTkeAppChartList(ctx context.Context, region string, kind str
 		request.ClusterType = &clusterType
 	}
 	
-	response, err := client.GetTkeAppChartList(request)
+	response, err := client.GetTkeAppChartListWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 			}).Error("查询可安装 addon 列表 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询可安装 addon 列表失败: %w", err)
 	}
@@ -810,7 +811,7 @@ func (c *Client) GetTkeAppChartList(ctx context.Context, region string, kind str
 	}
 	info.ChartCount = len(info.Charts)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"chart_count": info.ChartCount,
 	}).Info("成功查询可安装 addon 列表")
@@ -883,7 +884,7 @@ type ImageListInfo struct {
 
 // DescribeImages 获取指定地域支持的 OS 镜像列表
 func (c *Client) DescribeImages(ctx context.Context, region string) (*ImageListInfo, error) {
-	c.logger.WithField("region", region).Debug("开始查询 OS 镜像列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询 OS 镜像列表")
 	
 	credential := c.manager.GetCredential()
 	clientProfile := c.manager.GetClientProfile("tke")
@@ -895,16 +896,16 @@ func (c *Client) DescribeImages(ctx context.Context, region string) (*ImageListI
 	
 	request := tke.NewDescribeImagesRequest()
 	
-	response, err := client.DescribeImages(request)
+	response, err := client.DescribeImagesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 			}).Error("查询 OS 镜像列表 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询 OS 镜像列表失败: %w", err)
 	}
@@ -923,7 +924,7 @@ func (c *Client) DescribeImages(ctx context.Context, region string) (*ImageListI
 	}
 	info.ImageCount = len(info.Images)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":      region,
 		"image_count": info.ImageCount,
 	}).Info("成功查询 OS 镜像列表")
@@ -985,7 +986,7 @@ type VersionListInfo struct {
 
 // DescribeVersions 获取指定地域支持的集群版本列表
 func (c *Client) DescribeVersions(ctx context.Context, region string) (*VersionListInfo, error) {
-	c.logger.WithField("region", region).Debug("开始查询集群版本列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询集群版本列表")
 	
 	credential := c.manager.GetCredential()
 	clientProfile := c.manager.GetClientProfile("tke")
@@ -997,16 +998,16 @@ func (c *Client) DescribeVersions(ctx context.Context, region string) (*VersionL
 	
 	request := tke.NewDescribeVersionsRequest()
 	
-	response, err := client.DescribeVersions(request)
+	response, err := client.DescribeVersionsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 			}).Error("查询集群版本列表 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询集群版本列表失败: %w", err)
 	}
@@ -1024,7 +1025,7 @@ func (c *Client) DescribeVersions(ctx context.Context, region string) (*VersionL
 	}
 	info.VersionCount = len(info.Versions)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"version_count": info.VersionCount,
 	}).Info("成功查询集群版本列表")
@@ -1088,9 +1089,52 @@ type ClusterLogSwitchInfo struct {
 	MasterLog *LogSwitchDetailInfo `json:"master_log,omitempty"`
 }
 
+// DescribeClusterKubeconfig 获取集群的 kubeconfig
+// extranet 为 true 时返回外网访问的 kubeconfig，否则返回内网访问的 kubeconfig
+func (c *Client) DescribeClusterKubeconfig(ctx context.Context, region string, clusterID string, extranet bool) (string, error) {
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
+		"region":     region,
+		"cluster_id": clusterID,
+		"extranet":   extranet,
+	}).Debug("开始获取集群 kubeconfig")
+	
+	credential := c.manager.GetCredential()
+	clientProfile := c.manager.GetClientProfile("tke")
+	
+	client, err := tke.NewClient(credential, region, clientProfile)
+	if err != nil {
+		return "", fmt.Errorf("创建 TKE 客户端失败: %w", err)
+	}
+	
+	request := tke.NewDescribeClusterKubeconfigRequest()
+	request.ClusterId = &clusterID
+	request.IsExtranet = &extranet
+	
+	response, err := client.DescribeClusterKubeconfigWithContext(ctx, request)
+	if err != nil {
+		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
+				"code":       sdkError.Code,
+				"message":    sdkError.Message,
+				"request_id": sdkError.RequestId,
+				"region":     region,
+				"cluster_id": clusterID,
+			}).Error("获取集群 kubeconfig API 调用失败")
+			return "", tencentcloud.NewAPIError("TKE", sdkError)
+		}
+		return "", fmt.Errorf("获取集群 kubeconfig 失败: %w", err)
+	}
+	
+	if response.Response == nil || response.Response.Kubeconfig == nil {
+		return "", fmt.Errorf("集群 %s 没有返回 kubeconfig", clusterID)
+	}
+	
+	return *response.Response.Kubeconfig, nil
+}
+
 // DescribeLogSwitches 查询集群日志开关信息
 func (c *Client) DescribeLogSwitches(ctx context.Context, region string, clusterID string) (*ClusterLogSwitchInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 	}).Debug("开始查询集群日志开关信息")
@@ -1106,17 +1150,17 @@ func (c *Client) DescribeLogSwitches(ctx context.Context, region string, cluster
 	request := tke.NewDescribeLogSwitchesRequest()
 	request.ClusterIds = []*string{&clusterID}
 	
-	response, err := client.DescribeLogSwitches(request)
+	response, err := client.DescribeLogSwitchesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 				"cluster_id": clusterID,
 			}).Error("查询集群日志开关 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询集群日志开关失败: %w", err)
 	}
@@ -1170,7 +1214,7 @@ func (c *Client) DescribeLogSwitches(ctx context.Context, region string, cluster
 		}
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 	}).Info("成功查询集群日志开关信息")
@@ -1235,7 +1279,7 @@ type MasterComponentInfo struct {
 
 // DescribeMasterComponent 查询 master 组件状态
 func (c *Client) DescribeMasterComponent(ctx context.Context, region string, clusterID string, component string) (*MasterComponentInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 		"component":  component,
@@ -1253,10 +1297,10 @@ func (c *Client) DescribeMasterComponent(ctx context.Context, region string, clu
 	request.ClusterId = &clusterID
 	request.Component = &component
 	
-	response, err := client.DescribeMasterComponent(request)
+	response, err := client.DescribeMasterComponentWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
@@ -1264,7 +1308,7 @@ func (c *Client) DescribeMasterComponent(ctx context.Context, region string, clu
 				"cluster_id": clusterID,
 				"component":  component,
 			}).Error("查询 master 组件状态 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询 master 组件状态失败: %w", err)
 	}
@@ -1280,7 +1324,7 @@ func (c *Client) DescribeMasterComponent(ctx context.Context, region string, clu
 		info.Status = getStringValue(response.Response.Status)
 	}
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 		"component":  info.Component,
@@ -1331,7 +1375,7 @@ type ClusterInstanceListInfo struct {
 
 // DescribeClusterInstances 查询集群节点实例列表
 func (c *Client) DescribeClusterInstances(ctx context.Context, region string, clusterID string, instanceRole string) (*ClusterInstanceListInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":        region,
 		"cluster_id":    clusterID,
 		"instance_role": instanceRole,
@@ -1362,17 +1406,17 @@ func (c *Client) DescribeClusterInstances(ctx context.Context, region string, cl
 			request.InstanceRole = &instanceRole
 		}
 		
-		response, err := client.DescribeClusterInstances(request)
+		response, err := client.DescribeClusterInstancesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-				c.logger.WithFields(logrus.Fields{
+				c.logger.WithContext(ctx).WithFields(logrus.Fields{
 					"code":       sdkError.Code,
 					"message":    sdkError.Message,
 					"request_id": sdkError.RequestId,
 					"region":     region,
 					"cluster_id": clusterID,
 				}).Error("查询集群节点实例 API 调用失败")
-				return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+				return nil, tencentcloud.NewAPIError("TKE", sdkError)
 			}
 			return nil, fmt.Errorf("查询集群节点实例失败: %w", err)
 		}
@@ -1396,6 +1440,10 @@ func (c *Client) DescribeClusterInstances(ctx context.Context, region string, cl
 			}
 		}
 		
+		// 每获取一页上报一次进度，节点较多的集群需要多次分页查询
+		progress.Report(ctx, float64(len(info.Instances)), float64(info.TotalCount),
+			fmt.Sprintf("已获取 %d/%d 个节点", len(info.Instances), info.TotalCount))
+		
 		if int64(len(info.Instances)) >= info.TotalCount {
 			break
 		}
@@ -1403,7 +1451,7 @@ func (c *Client) DescribeClusterInstances(ctx context.Context, region string, cl
 	}
 	info.InstanceCount = len(info.Instances)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":         region,
 		"cluster_id":     clusterID,
 		"instance_count": info.InstanceCount,
@@ -1472,7 +1520,7 @@ type VirtualNodeListInfo struct {
 
 // DescribeClusterVirtualNode 查询集群超级节点列表
 func (c *Client) DescribeClusterVirtualNode(ctx context.Context, region string, clusterID string, nodePoolId string) (*VirtualNodeListInfo, error) {
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":       region,
 		"cluster_id":   clusterID,
 		"node_pool_id": nodePoolId,
@@ -1492,17 +1540,17 @@ func (c *Client) DescribeClusterVirtualNode(ctx context.Context, region string,
 		request.NodePoolId = &nodePoolId
 	}
 	
-	response, err := client.DescribeClusterVirtualNode(request)
+	response, err := client.DescribeClusterVirtualNodeWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			c.logger.WithFields(logrus.Fields{
+			c.logger.WithContext(ctx).WithFields(logrus.Fields{
 				"code":       sdkError.Code,
 				"message":    sdkError.Message,
 				"request_id": sdkError.RequestId,
 				"region":     region,
 				"cluster_id": clusterID,
 			}).Error("查询集群超级节点 API 调用失败")
-			return nil, fmt.Errorf("TKE API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("TKE", sdkError)
 		}
 		return nil, fmt.Errorf("查询集群超级节点失败: %w", err)
 	}
@@ -1530,7 +1578,7 @@ func (c *Client) DescribeClusterVirtualNode(ctx context.Context, region string,
 	}
 	info.NodeCount = len(info.Nodes)
 	
-	c.logger.WithFields(logrus.Fields{
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"region":     region,
 		"cluster_id": clusterID,
 		"node_count": info.NodeCount,
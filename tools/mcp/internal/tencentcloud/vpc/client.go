@@ -126,7 +126,7 @@ type DescribeVpcsResult struct {
 
 // DescribeVpcs 查询 VPC 列表
 func (c *Client) DescribeVpcs(ctx context.Context, region string) (*DescribeVpcsResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询 VPC 列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询 VPC 列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -137,10 +137,10 @@ func (c *Client) DescribeVpcs(ctx context.Context, region string) (*DescribeVpcs
 	limit := "100"
 	request.Limit = &limit
 
-	response, err := client.DescribeVpcs(request)
+	response, err := client.DescribeVpcsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询 VPC 列表失败: %w", err)
 	}
@@ -165,7 +165,7 @@ func (c *Client) DescribeVpcs(ctx context.Context, region string) (*DescribeVpcs
 		result.Vpcs = append(result.Vpcs, info)
 	}
 
-	c.logger.WithField("vpc_count", len(result.Vpcs)).Info("成功查询 VPC 列表")
+	c.logger.WithContext(ctx).WithField("vpc_count", len(result.Vpcs)).Info("成功查询 VPC 列表")
 	return result, nil
 }
 
@@ -232,7 +232,7 @@ type DescribeSubnetsResult struct {
 
 // DescribeSubnets 查询子网列表
 func (c *Client) DescribeSubnets(ctx context.Context, region string, vpcId string) (*DescribeSubnetsResult, error) {
-	c.logger.WithFields(logrus.Fields{"region": region, "vpc_id": vpcId}).Debug("开始查询子网列表")
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{"region": region, "vpc_id": vpcId}).Debug("开始查询子网列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -252,10 +252,10 @@ func (c *Client) DescribeSubnets(ctx context.Context, region string, vpcId strin
 		}
 	}
 
-	response, err := client.DescribeSubnets(request)
+	response, err := client.DescribeSubnetsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询子网列表失败: %w", err)
 	}
@@ -282,7 +282,7 @@ func (c *Client) DescribeSubnets(ctx context.Context, region string, vpcId strin
 		result.Subnets = append(result.Subnets, info)
 	}
 
-	c.logger.WithField("subnet_count", len(result.Subnets)).Info("成功查询子网列表")
+	c.logger.WithContext(ctx).WithField("subnet_count", len(result.Subnets)).Info("成功查询子网列表")
 	return result, nil
 }
 
@@ -339,7 +339,7 @@ type DescribeSecurityGroupsResult struct {
 
 // DescribeSecurityGroups 查询安全组列表
 func (c *Client) DescribeSecurityGroups(ctx context.Context, region string) (*DescribeSecurityGroupsResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询安全组列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询安全组列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -350,10 +350,10 @@ func (c *Client) DescribeSecurityGroups(ctx context.Context, region string) (*De
 	limit := "100"
 	request.Limit = &limit
 
-	response, err := client.DescribeSecurityGroups(request)
+	response, err := client.DescribeSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询安全组列表失败: %w", err)
 	}
@@ -376,7 +376,7 @@ func (c *Client) DescribeSecurityGroups(ctx context.Context, region string) (*De
 		result.SecurityGroups = append(result.SecurityGroups, info)
 	}
 
-	c.logger.WithField("sg_count", len(result.SecurityGroups)).Info("成功查询安全组列表")
+	c.logger.WithContext(ctx).WithField("sg_count", len(result.SecurityGroups)).Info("成功查询安全组列表")
 	return result, nil
 }
 
@@ -438,7 +438,7 @@ type DescribeNetworkInterfacesResult struct {
 
 // DescribeNetworkInterfaces 查询弹性网卡列表
 func (c *Client) DescribeNetworkInterfaces(ctx context.Context, region string, vpcId string) (*DescribeNetworkInterfacesResult, error) {
-	c.logger.WithFields(logrus.Fields{"region": region, "vpc_id": vpcId}).Debug("开始查询弹性网卡列表")
+	c.logger.WithContext(ctx).WithFields(logrus.Fields{"region": region, "vpc_id": vpcId}).Debug("开始查询弹性网卡列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -458,10 +458,10 @@ func (c *Client) DescribeNetworkInterfaces(ctx context.Context, region string, v
 		}
 	}
 
-	response, err := client.DescribeNetworkInterfaces(request)
+	response, err := client.DescribeNetworkInterfacesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询弹性网卡列表失败: %w", err)
 	}
@@ -495,7 +495,7 @@ func (c *Client) DescribeNetworkInterfaces(ctx context.Context, region string, v
 		result.NetworkInterfaces = append(result.NetworkInterfaces, info)
 	}
 
-	c.logger.WithField("eni_count", len(result.NetworkInterfaces)).Info("成功查询弹性网卡列表")
+	c.logger.WithContext(ctx).WithField("eni_count", len(result.NetworkInterfaces)).Info("成功查询弹性网卡列表")
 	return result, nil
 }
 
@@ -565,7 +565,7 @@ type DescribeAddressesResult struct {
 
 // DescribeAddresses 查询弹性公网IP列表
 func (c *Client) DescribeAddresses(ctx context.Context, region string) (*DescribeAddressesResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询弹性公网IP列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询弹性公网IP列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -576,10 +576,10 @@ func (c *Client) DescribeAddresses(ctx context.Context, region string) (*Describ
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeAddresses(request)
+	response, err := client.DescribeAddressesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询弹性公网IP列表失败: %w", err)
 	}
@@ -608,7 +608,7 @@ func (c *Client) DescribeAddresses(ctx context.Context, region string) (*Describ
 		result.Addresses = append(result.Addresses, info)
 	}
 
-	c.logger.WithField("address_count", len(result.Addresses)).Info("成功查询弹性公网IP列表")
+	c.logger.WithContext(ctx).WithField("address_count", len(result.Addresses)).Info("成功查询弹性公网IP列表")
 	return result, nil
 }
 
@@ -675,7 +675,7 @@ type DescribeBandwidthPackagesResult struct {
 
 // DescribeBandwidthPackages 查询带宽包列表
 func (c *Client) DescribeBandwidthPackages(ctx context.Context, region string) (*DescribeBandwidthPackagesResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询带宽包列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询带宽包列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -686,10 +686,10 @@ func (c *Client) DescribeBandwidthPackages(ctx context.Context, region string) (
 	var limit uint64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeBandwidthPackages(request)
+	response, err := client.DescribeBandwidthPackagesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询带宽包列表失败: %w", err)
 	}
@@ -714,7 +714,7 @@ func (c *Client) DescribeBandwidthPackages(ctx context.Context, region string) (
 		result.BandwidthPackages = append(result.BandwidthPackages, info)
 	}
 
-	c.logger.WithField("bwp_count", len(result.BandwidthPackages)).Info("成功查询带宽包列表")
+	c.logger.WithContext(ctx).WithField("bwp_count", len(result.BandwidthPackages)).Info("成功查询带宽包列表")
 	return result, nil
 }
 
@@ -773,7 +773,7 @@ type DescribeVpcEndPointResult struct {
 
 // DescribeVpcEndPoint 查询终端节点列表
 func (c *Client) DescribeVpcEndPoint(ctx context.Context, region string) (*DescribeVpcEndPointResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询终端节点列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询终端节点列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -784,10 +784,10 @@ func (c *Client) DescribeVpcEndPoint(ctx context.Context, region string) (*Descr
 	var limit uint64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeVpcEndPoint(request)
+	response, err := client.DescribeVpcEndPointWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询终端节点列表失败: %w", err)
 	}
@@ -812,7 +812,7 @@ func (c *Client) DescribeVpcEndPoint(ctx context.Context, region string) (*Descr
 		result.EndPoints = append(result.EndPoints, info)
 	}
 
-	c.logger.WithField("endpoint_count", len(result.EndPoints)).Info("成功查询终端节点列表")
+	c.logger.WithContext(ctx).WithField("endpoint_count", len(result.EndPoints)).Info("成功查询终端节点列表")
 	return result, nil
 }
 
@@ -870,7 +870,7 @@ type DescribeVpcEndPointServiceResult struct {
 
 // DescribeVpcEndPointService 查询终端节点服务列表
 func (c *Client) DescribeVpcEndPointService(ctx context.Context, region string) (*DescribeVpcEndPointServiceResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询终端节点服务列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询终端节点服务列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -881,10 +881,10 @@ func (c *Client) DescribeVpcEndPointService(ctx context.Context, region string)
 	var limit uint64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeVpcEndPointService(request)
+	response, err := client.DescribeVpcEndPointServiceWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询终端节点服务列表失败: %w", err)
 	}
@@ -909,7 +909,7 @@ func (c *Client) DescribeVpcEndPointService(ctx context.Context, region string)
 		result.EndPointServices = append(result.EndPointServices, info)
 	}
 
-	c.logger.WithField("eps_count", len(result.EndPointServices)).Info("成功查询终端节点服务列表")
+	c.logger.WithContext(ctx).WithField("eps_count", len(result.EndPointServices)).Info("成功查询终端节点服务列表")
 	return result, nil
 }
 
@@ -974,7 +974,7 @@ type DescribeVpcPeeringConnectionsResult struct {
 
 // DescribeVpcPeeringConnections 查询对等连接列表
 func (c *Client) DescribeVpcPeeringConnections(ctx context.Context, region string) (*DescribeVpcPeeringConnectionsResult, error) {
-	c.logger.WithField("region", region).Debug("开始查询对等连接列表")
+	c.logger.WithContext(ctx).WithField("region", region).Debug("开始查询对等连接列表")
 
 	client, err := c.newRegionClient(region)
 	if err != nil {
@@ -985,10 +985,10 @@ func (c *Client) DescribeVpcPeeringConnections(ctx context.Context, region strin
 	var limit int64 = 100
 	request.Limit = &limit
 
-	response, err := client.DescribeVpcPeeringConnections(request)
+	response, err := client.DescribeVpcPeeringConnectionsWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
-			return nil, fmt.Errorf("VPC API 错误 [%s]: %s", sdkError.Code, sdkError.Message)
+			return nil, tencentcloud.NewAPIError("VPC", sdkError)
 		}
 		return nil, fmt.Errorf("查询对等连接列表失败: %w", err)
 	}
@@ -1015,7 +1015,7 @@ func (c *Client) DescribeVpcPeeringConnections(ctx context.Context, region strin
 		result.PeerConnections = append(result.PeerConnections, info)
 	}
 
-	c.logger.WithField("peering_count", len(result.PeerConnections)).Info("成功查询对等连接列表")
+	c.logger.WithContext(ctx).WithField("peering_count", len(result.PeerConnections)).Info("成功查询对等连接列表")
 	return result, nil
 }
 
@@ -0,0 +1,285 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	mcp "github.com/metoro-io/mcp-golang"
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/progress"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// 批量调用限制
+const (
+	defaultBatchConcurrency = 4
+	maxBatchConcurrency     = 10
+	maxBatchCalls           = 50
+)
+
+// 批量调用中单个调用的错误码，工具执行失败时使用工具错误分类
+const (
+	BatchErrorTimeout      = "timeout"
+	BatchErrorToolNotFound = "tool_not_found"
+	BatchErrorSkipped      = "skipped"
+	BatchErrorBusy         = "server_busy"
+	BatchErrorInternal     = "internal_error"
+)
+
+// BatchCall 批量调用中的单个工具调用
+type BatchCall struct {
+	ToolName  string
+	Arguments map[string]interface{}
+	Timeout   time.Duration // 0 表示只受工具执行超时限制
+}
+
+// BatchOptions 批量调用选项
+type BatchOptions struct {
+	MaxConcurrency int                // 同时执行的调用数，0 表示使用默认值
+	FailFast       bool               // 任一调用失败后取消尚未完成的调用
+	Acquire        caller.AcquireFunc // 为每个子调用获取调用方的并发名额，nil 表示只受 MaxConcurrency 限制
+}
+
+// BatchCallItemResult 批量调用中单个调用的结果
+type BatchCallItemResult struct {
+	Index           int                    `json:"index"`
+	Name            string                 `json:"name"`
+	Success         bool                   `json:"success"`
+	Result          map[string]interface{} `json:"result,omitempty"`
+	ErrorCode       string                 `json:"error_code,omitempty"`
+	ErrorMessage    string                 `json:"error_message,omitempty"`
+	ErrorDetails    map[string]interface{} `json:"error_details,omitempty"`
+	ExecutionTimeMs float64                `json:"execution_time_ms"`
+}
+
+// BatchCallResult 批量调用的结果，Results 与请求中的调用顺序一致
+type BatchCallResult struct {
+	Total        int                   `json:"total"`
+	SuccessCount int                   `json:"success_count"`
+	FailureCount int                   `json:"failure_count"`
+	Results      []BatchCallItemResult `json:"results"`
+}
+
+// BatchCallArgumentItem batch_call工具中的单个调用
+type BatchCallArgumentItem struct {
+	Name           string                 `json:"name" jsonschema:"description=要调用的工具名称,required"`
+	Arguments      map[string]interface{} `json:"arguments,omitempty" jsonschema:"description=工具参数，与单独调用该工具时相同"`
+	TimeoutSeconds *int                   `json:"timeout_seconds,omitempty" jsonschema:"description=该调用的超时时间(秒)，不超过工具本身的执行超时"`
+}
+
+// BatchCallArguments batch_call工具的参数结构
+type BatchCallArguments struct {
+	Calls          []BatchCallArgumentItem `json:"calls" jsonschema:"description=要执行的工具调用列表(最多50个)，结果按相同顺序返回,required"`
+	MaxConcurrency *int                    `json:"max_concurrency,omitempty" jsonschema:"description=同时执行的调用数(1-10),default=4"`
+	FailFast       *bool                   `json:"fail_fast,omitempty" jsonschema:"description=任一调用失败后取消其余尚未完成的调用,default=false"`
+}
+
+// BatchCallHandler batch_call工具的处理函数
+// 并发执行多个工具调用，单个调用失败不影响整体结果，失败信息记录在对应的结果项中；
+// 每个子调用与单独调用工具一样占用调用方的并发名额
+func BatchCallHandler(ctx context.Context, arguments BatchCallArguments) (*mcp.ToolResponse, error) {
+	calls := make([]BatchCall, 0, len(arguments.Calls))
+	for _, item := range arguments.Calls {
+		call := BatchCall{
+			ToolName:  item.Name,
+			Arguments: item.Arguments,
+		}
+		if item.TimeoutSeconds != nil {
+			if *item.TimeoutSeconds <= 0 {
+				return nil, InvalidArgumentError("调用 %s 的 timeout_seconds 必须大于 0", item.Name)
+			}
+			call.Timeout = time.Duration(*item.TimeoutSeconds) * time.Second
+		}
+		calls = append(calls, call)
+	}
+
+	options := BatchOptions{
+		Acquire: caller.AcquirerFromContext(ctx),
+	}
+	if arguments.MaxConcurrency != nil {
+		options.MaxConcurrency = *arguments.MaxConcurrency
+	}
+	if arguments.FailFast != nil {
+		options.FailFast = *arguments.FailFast
+	}
+
+	result, err := GetGlobalRegistry().BatchCall(ctx, calls, options)
+	if err != nil {
+		return nil, err
+	}
+	SetStructuredResult(ctx, result)
+
+	content, err := NewJSONContent(result)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResponse(content), nil
+}
+
+// BatchCall 按限定的并发数执行一组工具调用
+// 调用列表或选项无效时返回 *ToolError，否则总是返回全部调用的结果；fail_fast 时失败后未开始的调用记为 skipped，
+// 进行中的调用被取消。每完成一个调用上报一次进度
+func (r *GlobalToolRegistry) BatchCall(ctx context.Context, calls []BatchCall, options BatchOptions) (*BatchCallResult, error) {
+	if len(calls) == 0 {
+		return nil, InvalidArgumentError("参数 calls 不能为空")
+	}
+	if len(calls) > maxBatchCalls {
+		return nil, InvalidArgumentError("单次最多批量调用 %d 个工具", maxBatchCalls)
+	}
+	concurrency := options.MaxConcurrency
+	if concurrency == 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	if concurrency < 1 || concurrency > maxBatchConcurrency {
+		return nil, InvalidArgumentError("参数 max_concurrency 必须在 1 到 %d 之间", maxBatchConcurrency)
+	}
+	for _, call := range calls {
+		if call.ToolName == "" {
+			return nil, InvalidArgumentError("调用的工具名称不能为空")
+		}
+		if call.ToolName == "batch_call" {
+			return nil, InvalidArgumentError("batch_call 不能嵌套调用")
+		}
+	}
+
+	// 子调用的进度不转发给外层，外层只看到批量调用整体的完成数
+	batchCtx, cancel := context.WithCancel(progress.WithReporter(ctx, nil))
+	defer cancel()
+
+	results := make([]BatchCallItemResult, len(calls))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	completed := 0
+
+	for i, call := range calls {
+		results[i] = BatchCallItemResult{Index: i, Name: call.ToolName}
+
+		acquired := false
+		select {
+		case semaphore <- struct{}{}:
+			acquired = true
+		case <-batchCtx.Done():
+		}
+		if batchCtx.Err() != nil {
+			if acquired {
+				<-semaphore
+			}
+			results[i].ErrorCode = BatchErrorSkipped
+			results[i].ErrorMessage = "调用未执行：批量调用已取消"
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, call BatchCall) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			r.runBatchItem(batchCtx, call, options.Acquire, &results[i])
+
+			mutex.Lock()
+			completed++
+			done := completed
+			mutex.Unlock()
+			progress.Report(ctx, float64(done), float64(len(calls)), fmt.Sprintf("已完成 %s", call.ToolName))
+
+			if !results[i].Success && options.FailFast {
+				cancel()
+			}
+		}(i, call)
+	}
+	wg.Wait()
+
+	result := &BatchCallResult{
+		Total:   len(calls),
+		Results: results,
+	}
+	for _, item := range results {
+		if item.Success {
+			result.SuccessCount++
+		} else {
+			result.FailureCount++
+		}
+	}
+
+	logger.WithContext(ctx).WithFields(logrus.Fields{
+		"total":     result.Total,
+		"succeeded": result.SuccessCount,
+		"failed":    result.FailureCount,
+		"fail_fast": options.FailFast,
+	}).Info("Batch tool call completed")
+
+	return result, nil
+}
+
+// runBatchItem 执行批量调用中的单个调用并记录结果
+// acquire 不为空时先获取并发名额，排队等待的时间不计入该调用的超时
+func (r *GlobalToolRegistry) runBatchItem(ctx context.Context, call BatchCall, acquire caller.AcquireFunc, item *BatchCallItemResult) {
+	if acquire != nil {
+		release, err := acquire(ctx)
+		if err != nil {
+			item.ErrorCode, item.ErrorMessage, item.ErrorDetails = describeAcquireError(err)
+			return
+		}
+		// 子调用超时后处理函数仍在运行时，名额保留到其返回
+		ctx, release = caller.WithSlot(ctx, release)
+		defer release()
+	}
+
+	if call.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, call.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	result, err := r.CallTool(ctx, call.ToolName, call.Arguments)
+	item.ExecutionTimeMs = float64(time.Since(start).Microseconds()) / 1000
+
+	switch {
+	case err != nil:
+		item.ErrorCode, item.ErrorMessage, item.ErrorDetails = describeCallError(err)
+	case result == nil:
+		item.ErrorCode = BatchErrorToolNotFound
+		item.ErrorMessage = "工具不存在或已禁用: " + call.ToolName
+	default:
+		item.Success = true
+		item.Result = result
+	}
+}
+
+// describeAcquireError 将获取并发名额的错误转换为错误码、错误信息和详细信息
+// 排队已满或排队超时为 server_busy，排队期间批量调用被取消为 skipped
+func describeAcquireError(err error) (string, string, map[string]interface{}) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return BatchErrorSkipped, "调用未执行：排队期间批量调用已取消或超时", nil
+	}
+	var busyErr interface {
+		Details() map[string]interface{}
+	}
+	if errors.As(err, &busyErr) {
+		return BatchErrorBusy, err.Error(), busyErr.Details()
+	}
+	return BatchErrorBusy, err.Error(), nil
+}
+
+// describeCallError 将工具调用错误转换为错误码、错误信息和详细信息
+// 工具执行失败时错误码为错误分类，超时为 timeout，调用被取消为 skipped
+func describeCallError(err error) (string, string, map[string]interface{}) {
+	var timeoutErr *ToolTimeoutError
+	var toolErr *ToolError
+	switch {
+	case errors.As(err, &timeoutErr):
+		return BatchErrorTimeout, timeoutErr.Error(), timeoutErr.Details()
+	case errors.As(err, &toolErr):
+		return toolErr.ErrorCategory(), toolErr.Error(), toolErr.Details()
+	case errors.Is(err, context.Canceled):
+		return BatchErrorSkipped, "调用已取消", nil
+	default:
+		return BatchErrorInternal, err.Error(), nil
+	}
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// callbackResolveTimeout 提交任务时解析回调主机的超时时间
+const callbackResolveTimeout = 5 * time.Second
+
+// blockedCallbackNetworks 除回环、私有、链路本地地址外不允许作为回调目标的网段
+// 100.64.0.0/10 为运营商级NAT地址，部分云厂商的元数据服务位于其中
+var blockedCallbackNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+)
+
+// mustParseCIDRs 解析内置的网段列表
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isBlockedCallbackIP 判断地址是否不允许作为回调目标
+// 回环、私有、链路本地（含 169.254.169.254 等元数据服务）、未指定和组播地址都不允许，避免回调被用于访问内网服务
+func isBlockedCallbackIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, network := range blockedCallbackNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCallbackHost 判断主机是否在允许的回调主机列表中，*.example.com 匹配 example.com 的所有子域名
+func matchCallbackHost(host string, allowedHosts []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasPrefix(suffix, ".") {
+			if strings.HasSuffix(host, suffix) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCallbackURL 校验回调地址：必须是 http/https 地址，主机在允许列表中，且解析结果不包含内网或保留地址
+func checkCallbackURL(callbackURL string, allowedHosts []string) error {
+	parsed, err := url.Parse(callbackURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return InvalidArgumentError("callback_url 必须是 http 或 https 地址: %s", callbackURL)
+	}
+	if len(allowedHosts) == 0 {
+		return InvalidArgumentError("未配置允许的回调主机 (MCP_TASK_CALLBACK_ALLOWED_HOSTS)，不支持 callback_url")
+	}
+
+	host := parsed.Hostname()
+	if !matchCallbackHost(host, allowedHosts) {
+		return InvalidArgumentError("回调主机 %s 不在允许的列表中", host)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callbackResolveTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return InvalidArgumentError("无法解析回调主机 %s: %v", host, err)
+	}
+	for _, addr := range addrs {
+		if isBlockedCallbackIP(addr.IP) {
+			return InvalidArgumentError("回调主机 %s 解析到内网或保留地址 %s", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// newCallbackClient 创建投递回调的 HTTP 客户端
+// 连接建立时再次校验实际连接的地址，防止提交后主机名被重新解析到内网地址；不使用代理，不跟随重定向
+func newCallbackClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: callbackTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isBlockedCallbackIP(ip) {
+				return fmt.Errorf("callback address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: callbackTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: callbackTimeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package tools
+
+import "strings"
+
+// 工具分类，在 tools/list 的 _meta.categories 中返回，可用于 tools/list 和 search_tools 按分类过滤
+const (
+	CategoryTKE         = "tke"
+	CategoryCVM         = "cvm"
+	CategoryCLB         = "clb"
+	CategoryCDB         = "cdb"
+	CategoryVPC         = "vpc"
+	CategoryK8s         = "k8s"
+	CategoryDiagnostics = "diagnostics"
+)
+
+// ToolCategories 所有工具分类
+func ToolCategories() []string {
+	return []string{
+		CategoryTKE,
+		CategoryCVM,
+		CategoryCLB,
+		CategoryCDB,
+		CategoryVPC,
+		CategoryK8s,
+		CategoryDiagnostics,
+	}
+}
+
+// categoryPrefixes 工具名称前缀对应的产品分类
+var categoryPrefixes = map[string]string{
+	"tke_": CategoryTKE,
+	"cvm_": CategoryCVM,
+	"clb_": CategoryCLB,
+	"cdb_": CategoryCDB,
+	"vpc_": CategoryVPC,
+}
+
+// extraCategories 按工具名称补充的分类：涉及 Kubernetes 集群内部的工具归入 k8s，状态、日志、健康检查类工具归入 diagnostics
+var extraCategories = map[string][]string{
+	"ping":                              {CategoryDiagnostics},
+	"echo":                              {CategoryDiagnostics},
+	"system_info":                       {CategoryDiagnostics},
+	"tencentcloud_validate":             {CategoryDiagnostics},
+	"tke_describe_cluster_instances":    {CategoryK8s},
+	"tke_describe_cluster_virtual_node": {CategoryK8s},
+	"tke_describe_cluster_extra_args":   {CategoryK8s},
+	"tke_describe_master_component":     {CategoryK8s, CategoryDiagnostics},
+	"tke_describe_addon":                {CategoryK8s},
+	"tke_describe_versions":             {CategoryK8s},
+	"tke_get_app_chart_list":            {CategoryK8s},
+	"tke_describe_log_switches":         {CategoryDiagnostics},
+	"cvm_describe_instances_status":     {CategoryDiagnostics},
+	"clb_describe_target_health":        {CategoryDiagnostics},
+	"cdb_describe_slow_logs":            {CategoryDiagnostics},
+	"cdb_describe_error_log":            {CategoryDiagnostics},
+}
+
+// defaultCategories 根据工具名称推断分类，工具定义未显式设置分类时使用
+func defaultCategories(toolName string) []string {
+	var categories []string
+	for prefix, category := range categoryPrefixes {
+		if strings.HasPrefix(toolName, prefix) {
+			categories = append(categories, category)
+		}
+	}
+	return append(categories, extraCategories[toolName]...)
+}
+
+// hasCategory 判断工具是否属于指定分类
+func (d *ToolDefinition) hasCategory(category string) bool {
+	for _, c := range d.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
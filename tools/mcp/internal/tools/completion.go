@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// maxCompletionValues completion/complete 单次最多返回的候选值数量（MCP 规范上限）
+const maxCompletionValues = 100
+
+// defaultCompletionCacheTTL 候选值缓存的有效期
+const defaultCompletionCacheTTL = 5 * time.Minute
+
+// maxCompletionCacheEntries 候选值缓存的最大条目数，达到上限时先清理过期条目，仍然已满则淘汰最早获取的条目
+const maxCompletionCacheEntries = 1000
+
+// CompletionFetchFunc 获取参数候选值，arguments 为客户端已填写的其他参数
+type CompletionFetchFunc func(ctx context.Context, arguments map[string]string) ([]string, error)
+
+// CompletionSource 工具参数的候选值来源
+type CompletionSource struct {
+	// 适用的工具名称前缀，为空时适用于所有工具
+	ToolPrefix string
+
+	// 参数名称
+	Argument string
+
+	// 候选值依赖的其他参数（如 region），缺少时不返回候选值，取值作为缓存键的一部分
+	DependsOn []string
+
+	// 获取候选值
+	Fetch CompletionFetchFunc
+}
+
+// completionCacheEntry 候选值缓存项
+type completionCacheEntry struct {
+	values    []string
+	fetchedAt time.Time
+}
+
+// ArgumentCompleter 工具参数补全器
+// 按工具名称前缀和参数名称查找候选值来源，查询结果按依赖参数缓存，避免每次输入都调用云 API
+type ArgumentCompleter struct {
+	sources []*CompletionSource
+	cache   map[string]completionCacheEntry
+	ttl     time.Duration
+	mutex   sync.RWMutex
+}
+
+var (
+	// 全局参数补全器实例
+	globalCompleter = &ArgumentCompleter{
+		cache: make(map[string]completionCacheEntry),
+		ttl:   defaultCompletionCacheTTL,
+	}
+)
+
+// GetArgumentCompleter 获取全局参数补全器
+func GetArgumentCompleter() *ArgumentCompleter {
+	return globalCompleter
+}
+
+// RegisterSource 注册参数候选值来源
+func (c *ArgumentCompleter) RegisterSource(source *CompletionSource) error {
+	if source == nil || source.Argument == "" || source.Fetch == nil {
+		return fmt.Errorf("completion source must have an argument and a fetch function")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.sources = append(c.sources, source)
+
+	logger.WithFields(logrus.Fields{
+		"tool_prefix": source.ToolPrefix,
+		"argument":    source.Argument,
+	}).Debug("Registered completion source")
+	return nil
+}
+
+// findSource 查找工具参数的候选值来源，多个来源匹配时取工具名称前缀最长的
+func (c *ArgumentCompleter) findSource(toolName, argument string) *CompletionSource {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	var found *CompletionSource
+	for _, source := range c.sources {
+		if source.Argument != argument || !strings.HasPrefix(toolName, source.ToolPrefix) {
+			continue
+		}
+		if found == nil || len(source.ToolPrefix) > len(found.ToolPrefix) {
+			found = source
+		}
+	}
+	return found
+}
+
+// Complete 补全工具参数，返回 completion/complete 结果中的 completion 对象
+// 候选值按输入前缀（不区分大小写）过滤，最多返回 100 个；工具不存在或已禁用时返回 nil，由调用者处理
+func (c *ArgumentCompleter) Complete(ctx context.Context, toolName, argument, value string, arguments map[string]string) (map[string]interface{}, error) {
+	if !GetGlobalRegistry().IsToolEnabled(toolName) {
+		return nil, nil
+	}
+
+	var values []string
+	if source := c.findSource(toolName, argument); source != nil {
+		candidates, err := c.candidates(ctx, source, arguments)
+		if err != nil {
+			return nil, WrapToolError(fmt.Sprintf("获取参数 %s 的候选值失败", argument), err)
+		}
+		prefix := strings.ToLower(value)
+		for _, candidate := range candidates {
+			if strings.HasPrefix(strings.ToLower(candidate), prefix) {
+				values = append(values, candidate)
+			}
+		}
+	}
+
+	total := len(values)
+	if total > maxCompletionValues {
+		values = values[:maxCompletionValues]
+	}
+	if values == nil {
+		values = []string{}
+	}
+
+	return map[string]interface{}{
+		"values":  values,
+		"total":   total,
+		"hasMore": total > len(values),
+	}, nil
+}
+
+// candidates 获取候选值，优先使用未过期的缓存
+func (c *ArgumentCompleter) candidates(ctx context.Context, source *CompletionSource, arguments map[string]string) ([]string, error) {
+	key := source.ToolPrefix + "|" + source.Argument
+	for _, dependency := range source.DependsOn {
+		if arguments[dependency] == "" {
+			// 依赖参数尚未填写，无法确定候选值范围
+			return nil, nil
+		}
+		key += "|" + dependency + "=" + arguments[dependency]
+	}
+
+	c.mutex.RLock()
+	entry, cached := c.cache[key]
+	c.mutex.RUnlock()
+	if cached && time.Since(entry.fetchedAt) < c.ttl {
+		return entry.values, nil
+	}
+
+	values, err := source.Fetch(ctx, arguments)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(values)
+
+	c.mutex.Lock()
+	c.storeLocked(key, values)
+	c.mutex.Unlock()
+
+	logger.WithFields(logrus.Fields{
+		"cache_key":   key,
+		"value_count": len(values),
+	}).Debug("Fetched completion values")
+
+	return values, nil
+}
+
+// storeLocked 写入缓存并控制缓存大小，调用方需持有写锁
+func (c *ArgumentCompleter) storeLocked(key string, values []string) {
+	now := time.Now()
+	if _, exists := c.cache[key]; !exists && len(c.cache) >= maxCompletionCacheEntries {
+		var oldestKey string
+		var oldest time.Time
+		for cachedKey, entry := range c.cache {
+			if now.Sub(entry.fetchedAt) >= c.ttl {
+				delete(c.cache, cachedKey)
+				continue
+			}
+			if oldestKey == "" || entry.fetchedAt.Before(oldest) {
+				oldestKey, oldest = cachedKey, entry.fetchedAt
+			}
+		}
+		if len(c.cache) >= maxCompletionCacheEntries {
+			delete(c.cache, oldestKey)
+		}
+	}
+
+	c.cache[key] = completionCacheEntry{
+		values:    values,
+		fetchedAt: now,
+	}
+}
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	mcp "github.com/metoro-io/mcp-golang"
+)
+
+// emptyResultText 工具没有返回任何内容时的提示文本
+const emptyResultText = "工具执行完成，但没有返回内容"
+
+// ContentToMap 将工具返回的内容项转换为 tools/call 结果中的内容对象
+// 支持文本、图片和嵌入资源；mcp-golang 序列化嵌入资源时缺少 resource 包装层，这里按 MCP 规范重新组装
+func ContentToMap(content *mcp.Content) (map[string]interface{}, error) {
+	if content == nil {
+		return nil, fmt.Errorf("content is nil")
+	}
+
+	var item map[string]interface{}
+	switch content.Type {
+	case mcp.ContentTypeText:
+		if content.TextContent == nil {
+			return nil, fmt.Errorf("text content is empty")
+		}
+		item = map[string]interface{}{
+			"type": "text",
+			"text": content.TextContent.Text,
+		}
+	case mcp.ContentTypeImage:
+		if content.ImageContent == nil {
+			return nil, fmt.Errorf("image content is empty")
+		}
+		item = map[string]interface{}{
+			"type":     "image",
+			"data":     content.ImageContent.Data,
+			"mimeType": content.ImageContent.MimeType,
+		}
+	case mcp.ContentTypeEmbeddedResource:
+		if content.EmbeddedResource == nil {
+			return nil, fmt.Errorf("embedded resource is empty")
+		}
+		resource, err := json.Marshal(content.EmbeddedResource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal embedded resource: %w", err)
+		}
+		item = map[string]interface{}{
+			"type":     "resource",
+			"resource": json.RawMessage(resource),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", content.Type)
+	}
+
+	if content.Annotations != nil {
+		item["annotations"] = content.Annotations
+	}
+	return item, nil
+}
+
+// ContentsToMaps 按顺序转换工具返回的全部内容项，没有内容时返回一条提示文本
+func ContentsToMaps(contents []*mcp.Content) ([]map[string]interface{}, error) {
+	if len(contents) == 0 {
+		return []map[string]interface{}{
+			{
+				"type": "text",
+				"text": emptyResultText,
+			},
+		}, nil
+	}
+
+	items := make([]map[string]interface{}, 0, len(contents))
+	for i, content := range contents {
+		item, err := ContentToMap(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content item %d: %w", i, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// NewJSONContent 将结果序列化为带缩进的 JSON 文本内容项
+func NewJSONContent(value interface{}) (*mcp.Content, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json content: %w", err)
+	}
+	return mcp.NewTextContent(string(data)), nil
+}
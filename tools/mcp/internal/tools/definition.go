@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	mcp "github.com/metoro-io/mcp-golang"
+)
+
+// ToolHandlerFunc 工具处理函数类型
+// 所有传输模式统一以 JSON 对象形式的参数调用工具
+type ToolHandlerFunc func(ctx context.Context, arguments map[string]interface{}) (*mcp.ToolResponse, error)
+
+// ToolDefinition 工具定义
+// 一个工具只需定义一次，所有传输模式都经由全局工具注册表调用同一个处理入口
+type ToolDefinition struct {
+	// 工具名称
+	Name string
+
+	// 工具描述
+	Description string
+
+	// 参数的 JSON Schema，注册时由参数结构体生成
+	InputSchema map[string]interface{}
+
+	// 结果的 JSON Schema，声明了输出类型的工具在注册时由结果结构体生成
+	OutputSchema map[string]interface{}
+
+	// 工具分类，未设置时注册时根据工具名称推断
+	Categories []string
+
+	// 统一的工具调用入口
+	Handler ToolHandlerFunc
+
+	// 参数结构体类型
+	argsType reflect.Type
+
+	// 结果结构体类型，未声明时为 nil
+	outputType reflect.Type
+}
+
+// NewTool 根据类型化的处理函数创建工具定义
+// 参数结构体上的 jsonschema 标签用于生成 inputSchema，调用时 JSON 参数会被转换为该结构体
+func NewTool[T any](name, description string, handler func(ctx context.Context, args T) (*mcp.ToolResponse, error)) *ToolDefinition {
+	return &ToolDefinition{
+		Name:        name,
+		Description: description,
+		Handler: func(ctx context.Context, arguments map[string]interface{}) (*mcp.ToolResponse, error) {
+			var args T
+			if err := ConvertArgumentsToStruct(arguments, &args); err != nil {
+				return nil, InvalidArgumentError("参数转换失败: %v", err)
+			}
+			return handler(ctx, args)
+		},
+		argsType: reflect.TypeOf((*T)(nil)).Elem(),
+	}
+}
+
+// NewToolWithOutput 创建带类型化结果的工具定义
+// R 为结果结构体，注册时据此生成 outputSchema；处理函数通过 SetStructuredResult 记录该类型的结果，
+// 作为 structuredContent 返回给支持 2025-06-18 协议的客户端
+func NewToolWithOutput[R any, T any](name, description string, handler func(ctx context.Context, args T) (*mcp.ToolResponse, error)) *ToolDefinition {
+	def := NewTool(name, description, handler)
+	def.outputType = reflect.TypeOf((*R)(nil)).Elem()
+	return def
+}
+
+// WithCategories 设置工具分类
+func (d *ToolDefinition) WithCategories(categories ...string) *ToolDefinition {
+	d.Categories = categories
+	return d
+}
+
+// buildInputSchema 根据参数结构体生成 inputSchema
+func (d *ToolDefinition) buildInputSchema() error {
+	if d.argsType == nil {
+		return fmt.Errorf("tool %s has no argument type", d.Name)
+	}
+
+	schema, err := GenerateSchemaFromType(d.argsType)
+	if err != nil {
+		return fmt.Errorf("failed to generate input schema for tool %s: %w", d.Name, err)
+	}
+
+	d.InputSchema = schema
+	return nil
+}
+
+// buildOutputSchema 根据结果结构体生成 outputSchema，未声明输出类型时跳过
+func (d *ToolDefinition) buildOutputSchema() error {
+	if d.outputType == nil {
+		return nil
+	}
+
+	schema, err := GenerateSchemaFromType(d.outputType)
+	if err != nil {
+		return fmt.Errorf("failed to generate output schema for tool %s: %w", d.Name, err)
+	}
+
+	d.OutputSchema = schema
+	return nil
+}
+
+// ToMCPTool 转换为 tools/list 返回的工具描述
+func (d *ToolDefinition) ToMCPTool() map[string]interface{} {
+	tool := map[string]interface{}{
+		"name":        d.Name,
+		"description": d.Description,
+		"inputSchema": d.InputSchema,
+	}
+	if d.OutputSchema != nil {
+		tool["outputSchema"] = d.OutputSchema
+	}
+	if len(d.Categories) > 0 {
+		tool["_meta"] = map[string]interface{}{
+			"categories": d.Categories,
+		}
+	}
+	return tool
+}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -19,7 +20,7 @@ type EchoArguments struct {
 }
 
 // EchoHandler echo工具的处理函数
-func EchoHandler(arguments EchoArguments) (*mcp.ToolResponse, error) {
+func EchoHandler(ctx context.Context, arguments EchoArguments) (*mcp.ToolResponse, error) {
 	startTime := time.Now()
 	
 	// 处理文本
@@ -0,0 +1,158 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"ai-sre/tools/mcp/internal/kubernetes"
+	"ai-sre/tools/mcp/internal/tencentcloud"
+)
+
+// 工具错误分类
+const (
+	ErrorCategoryAuth            = "auth"
+	ErrorCategoryNotFound        = "not_found"
+	ErrorCategoryRateLimited     = "rate_limited"
+	ErrorCategoryInvalidArgument = "invalid_argument"
+	ErrorCategoryUpstream        = "upstream"
+)
+
+// errTencentCloudNotInitialized 腾讯云工具未初始化（通常是认证信息缺失）
+var errTencentCloudNotInitialized = NewToolError(ErrorCategoryAuth, "腾讯云工具未初始化，请检查配置")
+
+// ToolError 工具执行失败错误
+// 工具执行失败以 isError 结果返回给客户端而不是 JSON-RPC 错误，Category 供调用方区分失败原因
+type ToolError struct {
+	Category  string
+	Message   string
+	Code      string // 云 API 错误码
+	RequestID string // 云 API 请求ID
+	Err       error
+}
+
+// NewToolError 创建指定分类的工具错误
+func NewToolError(category, format string, args ...interface{}) *ToolError {
+	return &ToolError{
+		Category: category,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// InvalidArgumentError 创建参数错误
+func InvalidArgumentError(format string, args ...interface{}) *ToolError {
+	return NewToolError(ErrorCategoryInvalidArgument, format, args...)
+}
+
+// WrapToolError 包装工具执行错误
+// 分类沿错误链确定：已分类的工具错误保持原分类，腾讯云 API 错误按错误码映射，其余视为上游错误
+func WrapToolError(message string, err error) *ToolError {
+	toolErr := &ToolError{
+		Category: ErrorCategoryUpstream,
+		Message:  message,
+		Err:      err,
+	}
+
+	var inner *ToolError
+	var apiErr *tencentcloud.APIError
+	var kubeErr *kubernetes.APIError
+	switch {
+	case errors.As(err, &inner):
+		toolErr.Category = inner.Category
+		toolErr.Code = inner.Code
+		toolErr.RequestID = inner.RequestID
+	case errors.As(err, &apiErr):
+		toolErr.Category = CategorizeAPIErrorCode(apiErr.Code)
+		toolErr.Code = apiErr.Code
+		toolErr.RequestID = apiErr.RequestID
+	case errors.As(err, &kubeErr):
+		toolErr.Category = categorizeHTTPStatus(kubeErr.StatusCode)
+		toolErr.Code = fmt.Sprintf("%d", kubeErr.StatusCode)
+	}
+
+	return toolErr
+}
+
+// Error 实现 error 接口
+func (e *ToolError) Error() string {
+	switch {
+	case e.Err == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Err.Error()
+	default:
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+}
+
+// Unwrap 返回被包装的错误
+func (e *ToolError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCategory 返回错误分类
+func (e *ToolError) ErrorCategory() string {
+	return e.Category
+}
+
+// Details 返回结构化的错误信息
+func (e *ToolError) Details() map[string]interface{} {
+	details := map[string]interface{}{
+		"category": e.Category,
+	}
+	if e.Code != "" {
+		details["code"] = e.Code
+	}
+	if e.RequestID != "" {
+		details["request_id"] = e.RequestID
+	}
+	return details
+}
+
+// asToolError 将处理函数返回的错误统一转换为工具错误
+func asToolError(err error) *ToolError {
+	var toolErr *ToolError
+	if errors.As(err, &toolErr) {
+		return toolErr
+	}
+	return WrapToolError("", err)
+}
+
+// CategorizeAPIErrorCode 将腾讯云 API 错误码映射为错误分类
+// 错误码格式为 "一级错误码.二级错误码"，例如 AuthFailure.SignatureExpire、ResourceNotFound.ClusterNotFound
+func CategorizeAPIErrorCode(code string) string {
+	switch {
+	case strings.HasPrefix(code, "AuthFailure"),
+		strings.HasPrefix(code, "UnauthorizedOperation"),
+		strings.HasPrefix(code, "OperationDenied"):
+		return ErrorCategoryAuth
+	case strings.Contains(code, "NotFound"),
+		strings.Contains(code, "NotExist"):
+		return ErrorCategoryNotFound
+	case strings.HasPrefix(code, "RequestLimitExceeded"):
+		return ErrorCategoryRateLimited
+	case strings.HasPrefix(code, "Invalid"),
+		strings.HasPrefix(code, "MissingParameter"),
+		strings.HasPrefix(code, "UnknownParameter"),
+		strings.HasPrefix(code, "UnsupportedRegion"):
+		return ErrorCategoryInvalidArgument
+	default:
+		return ErrorCategoryUpstream
+	}
+}
+
+// categorizeHTTPStatus 将 HTTP 状态码（如 kube-apiserver 的错误响应）映射为错误分类
+func categorizeHTTPStatus(statusCode int) string {
+	switch {
+	case statusCode == 401 || statusCode == 403:
+		return ErrorCategoryAuth
+	case statusCode == 404:
+		return ErrorCategoryNotFound
+	case statusCode == 429:
+		return ErrorCategoryRateLimited
+	case statusCode == 400 || statusCode == 422:
+		return ErrorCategoryInvalidArgument
+	default:
+		return ErrorCategoryUpstream
+	}
+}
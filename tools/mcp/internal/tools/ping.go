@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,7 +14,7 @@ type PingArguments struct {
 }
 
 // PingHandler ping工具的处理函数
-func PingHandler(arguments PingArguments) (*mcp.ToolResponse, error) {
+func PingHandler(ctx context.Context, arguments PingArguments) (*mcp.ToolResponse, error) {
 	startTime := time.Now()
 	
 	// 如果没有提供消息，使用默认值
@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"sync"
+
+	"ai-sre/tools/mcp/internal/progress"
+)
+
+// Progress 工具执行进度
+type Progress struct {
+	Progress float64 `json:"progress"`
+	Total    float64 `json:"total,omitempty"`
+	Message  string  `json:"message,omitempty"`
+}
+
+// progressTracker 记录一次工具调用最近上报的进度
+type progressTracker struct {
+	last  *Progress
+	mutex sync.Mutex
+}
+
+// withProgressTracker 为工具调用创建进度记录器
+// 记录器接管上下文中的进度上报函数，记录最近的进度后继续交给外层（如 notifications/progress 推送）
+func withProgressTracker(ctx context.Context) (context.Context, *progressTracker) {
+	tracker := &progressTracker{}
+	parent := progress.FromContext(ctx)
+	ctx = progress.WithReporter(ctx, func(value, total float64, message string) {
+		tracker.record(value, total, message)
+		if parent != nil {
+			parent(value, total, message)
+		}
+	})
+	return ctx, tracker
+}
+
+// ReportProgress 上报工具执行进度，total 未知时传 0
+// 请求携带 _meta.progressToken 时，进度以 notifications/progress 推送给客户端
+func ReportProgress(ctx context.Context, value, total float64, message string) {
+	progress.Report(ctx, value, total, message)
+}
+
+// record 记录最近上报的进度
+func (t *progressTracker) record(value, total float64, message string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.last = &Progress{
+		Progress: value,
+		Total:    total,
+		Message:  message,
+	}
+}
+
+// Last 获取最近上报的进度，没有上报时返回 nil
+func (t *progressTracker) Last() *Progress {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.last == nil {
+		return nil
+	}
+	last := *t.last
+	return &last
+}
@@ -3,26 +3,72 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	mcp "github.com/metoro-io/mcp-golang"
 	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
 	"ai-sre/tools/mcp/pkg/logger"
 )
 
-// ToolHandlerFunc 工具处理函数类型
-type ToolHandlerFunc func(arguments interface{}) (*mcp.ToolResponse, error)
-
 // GlobalToolRegistry 全局工具注册表
+// stdio 与 HTTP 模式的工具调用最终都经由此注册表分发
 type GlobalToolRegistry struct {
-	handlers map[string]ToolHandlerFunc
-	mutex    sync.RWMutex
+	tools            map[string]*ToolDefinition
+	order            []string
+	executionTimeout time.Duration            // 默认工具执行超时，0 表示不限制
+	toolTimeouts     map[string]time.Duration // 按工具覆盖的执行超时
+	allowedTools     map[string]bool          // 配置允许的工具，为空时允许所有工具
+	disabledTools    map[string]bool          // 配置禁用的工具
+	enabledOverrides map[string]bool          // 运行时启用/禁用的工具，优先于配置
+	onListChanged    func()                   // 可用工具集合变化时的回调
+	mutex            sync.RWMutex
+}
+
+// ToolResult 工具调用结果
+type ToolResult struct {
+	Response          *mcp.ToolResponse
+	StructuredContent map[string]interface{} // 声明了输出类型的工具返回的结构化结果
+}
+
+// ToolTimeoutError 工具执行超时错误
+type ToolTimeoutError struct {
+	ToolName string
+	Timeout  time.Duration
+	Elapsed  time.Duration
+	Progress *Progress // 超时前最近上报的进度，可能为空
+}
+
+// Error 实现 error 接口
+func (e *ToolTimeoutError) Error() string {
+	return fmt.Sprintf("tool %s timed out after %s (limit %s)", e.ToolName, e.Elapsed.Round(time.Millisecond), e.Timeout)
+}
+
+// IsTimeout 标识该错误为超时错误
+func (e *ToolTimeoutError) IsTimeout() bool {
+	return true
+}
+
+// Details 返回超时错误的结构化信息
+func (e *ToolTimeoutError) Details() map[string]interface{} {
+	details := map[string]interface{}{
+		"tool":       e.ToolName,
+		"timeout_ms": e.Timeout.Milliseconds(),
+		"elapsed_ms": e.Elapsed.Milliseconds(),
+	}
+	if e.Progress != nil {
+		details["progress"] = e.Progress
+	}
+	return details
 }
 
 var (
 	// 全局工具注册表实例
 	globalRegistry = &GlobalToolRegistry{
-		handlers: make(map[string]ToolHandlerFunc),
+		tools: make(map[string]*ToolDefinition),
 	}
 )
 
@@ -31,68 +77,299 @@ func GetGlobalRegistry() *GlobalToolRegistry {
 	return globalRegistry
 }
 
-// RegisterHandler 注册工具处理函数
-func (r *GlobalToolRegistry) RegisterHandler(toolName string, handler ToolHandlerFunc) {
+// SetExecutionTimeouts 设置工具执行超时：默认超时及按工具名称的覆盖值
+func (r *GlobalToolRegistry) SetExecutionTimeouts(defaultTimeout time.Duration, overrides map[string]time.Duration) {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	
-	r.handlers[toolName] = handler
+
+	r.executionTimeout = defaultTimeout
+	r.toolTimeouts = make(map[string]time.Duration, len(overrides))
+	for name, timeout := range overrides {
+		r.toolTimeouts[name] = timeout
+	}
+}
+
+// GetExecutionTimeout 获取指定工具的执行超时
+func (r *GlobalToolRegistry) GetExecutionTimeout(toolName string) time.Duration {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if timeout, ok := r.toolTimeouts[toolName]; ok && timeout > 0 {
+		return timeout
+	}
+	return r.executionTimeout
+}
+
+// executionTimeoutContextKey 覆盖工具执行超时的值在上下文中的键
+type executionTimeoutContextKey struct{}
+
+// WithExecutionTimeout 覆盖本次调用的工具执行超时，异步任务据此使用比同步调用更长的超时
+// 只对直接发起的调用生效，batch_call 等工具内部发起的调用仍使用各自的执行超时
+func WithExecutionTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, executionTimeoutContextKey{}, timeout)
+}
+
+// SetToolFilter 设置配置的工具过滤规则：allowed 为空时允许所有工具，disabled 中的工具始终禁用
+func (r *GlobalToolRegistry) SetToolFilter(allowed, disabled []string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.allowedTools = make(map[string]bool, len(allowed))
+	for _, name := range allowed {
+		r.allowedTools[name] = true
+	}
+	r.disabledTools = make(map[string]bool, len(disabled))
+	for _, name := range disabled {
+		r.disabledTools[name] = true
+	}
+}
+
+// SetListChangedHandler 设置可用工具集合变化时的回调
+func (r *GlobalToolRegistry) SetListChangedHandler(handler func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.onListChanged = handler
+}
+
+// IsToolEnabled 判断工具是否已注册且可用
+// 运行时的启用/禁用优先，其次为配置的禁用列表和允许列表
+func (r *GlobalToolRegistry) IsToolEnabled(toolName string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.isEnabledLocked(toolName)
+}
+
+// isEnabledLocked 判断工具是否可用，调用者需持有锁
+func (r *GlobalToolRegistry) isEnabledLocked(toolName string) bool {
+	if _, exists := r.tools[toolName]; !exists {
+		return false
+	}
+	if enabled, ok := r.enabledOverrides[toolName]; ok {
+		return enabled
+	}
+	if r.disabledTools[toolName] {
+		return false
+	}
+	return len(r.allowedTools) == 0 || r.allowedTools[toolName]
+}
+
+// SetToolEnabled 运行时启用或禁用工具，返回可用状态是否发生变化
+// 状态变化时触发工具集合变化回调；工具未注册时返回错误
+func (r *GlobalToolRegistry) SetToolEnabled(toolName string, enabled bool) (bool, error) {
+	r.mutex.Lock()
+	if _, exists := r.tools[toolName]; !exists {
+		r.mutex.Unlock()
+		return false, fmt.Errorf("tool not found: %s", toolName)
+	}
+
+	changed := r.isEnabledLocked(toolName) != enabled
+	if r.enabledOverrides == nil {
+		r.enabledOverrides = make(map[string]bool)
+	}
+	r.enabledOverrides[toolName] = enabled
+	onListChanged := r.onListChanged
+	r.mutex.Unlock()
+
 	logger.WithFields(logrus.Fields{
 		"tool_name": toolName,
-	}).Debug("Registered tool handler in global registry")
+		"enabled":   enabled,
+		"changed":   changed,
+	}).Info("Tool availability updated")
+
+	if changed && onListChanged != nil {
+		onListChanged()
+	}
+	return changed, nil
 }
 
-// GetHandler 获取工具处理函数
-func (r *GlobalToolRegistry) GetHandler(toolName string) (ToolHandlerFunc, bool) {
+// Register 注册工具定义
+func (r *GlobalToolRegistry) Register(def *ToolDefinition) error {
+	if def == nil || def.Name == "" {
+		return fmt.Errorf("tool definition must have a name")
+	}
+	if def.Handler == nil {
+		return fmt.Errorf("tool %s has no handler", def.Name)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.tools[def.Name]; !exists {
+		r.order = append(r.order, def.Name)
+	}
+	r.tools[def.Name] = def
+
+	logger.WithFields(logrus.Fields{
+		"tool_name": def.Name,
+	}).Debug("Registered tool in global registry")
+	return nil
+}
+
+// GetTool 获取工具定义
+func (r *GlobalToolRegistry) GetTool(toolName string) (*ToolDefinition, bool) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
-	
-	handler, exists := r.handlers[toolName]
-	return handler, exists
+
+	def, exists := r.tools[toolName]
+	return def, exists
 }
 
-// CallTool 调用工具
-func (r *GlobalToolRegistry) CallTool(ctx context.Context, toolName string, arguments map[string]interface{}) (string, error) {
-	handler, exists := r.GetHandler(toolName)
+// Dispatch 分发工具调用，返回完整的工具响应及结构化结果
+// 调用受工具执行超时限制，超时返回 *ToolTimeoutError；工具执行失败返回 *ToolError
+func (r *GlobalToolRegistry) Dispatch(ctx context.Context, toolName string, arguments map[string]interface{}) (*ToolResult, error) {
+	def, exists := r.GetTool(toolName)
 	if !exists {
-		return "", nil // 返回空字符串表示工具不存在，让调用者处理
+		return nil, fmt.Errorf("tool not found: %s", toolName)
 	}
-	
-	logger.WithFields(logrus.Fields{
+	if !r.IsToolEnabled(toolName) {
+		return nil, fmt.Errorf("tool disabled: %s", toolName)
+	}
+
+	if arguments == nil {
+		arguments = map[string]interface{}{}
+	}
+
+	// 请求已被取消时不再执行工具
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool_name": toolName,
 		"arguments": arguments,
 	}).Debug("Calling tool via global registry")
-	
-	// 调用处理函数
-	response, err := handler(arguments)
-	if err != nil {
-		return "", err
-	}
-	
-	// 提取文本内容
-	if response != nil && len(response.Content) > 0 {
-		// 检查第一个内容项的类型
-		content := response.Content[0]
-		if content.TextContent != nil {
-			return content.TextContent.Text, nil
+
+	start := time.Now()
+	timeout := r.GetExecutionTimeout(toolName)
+	if override, ok := ctx.Value(executionTimeoutContextKey{}).(time.Duration); ok && override > 0 {
+		timeout = override
+		ctx = WithExecutionTimeout(ctx, 0)
+	}
+	execCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		execCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+	execCtx, tracker := withProgressTracker(execCtx)
+	execCtx, recorder := withStructuredResult(execCtx)
+
+	type result struct {
+		response *mcp.ToolResponse
+		err      error
+	}
+	done := make(chan result, 1)
+
+	// 处理函数在独立的 goroutine 中执行，即使其未响应取消也能按时返回；
+	// 调用方的并发名额保留到处理函数真正返回，超时后仍在运行的处理函数继续计入并发
+	unhold := func() {}
+	if slot := caller.SlotFromContext(ctx); slot != nil {
+		unhold = slot.Hold()
+	}
+	go func() {
+		defer unhold()
+		response, err := def.Handler(execCtx, arguments)
+		done <- result{response: response, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		if !errors.Is(execCtx.Err(), context.DeadlineExceeded) {
+			if res.err != nil {
+				// 处理函数返回的错误统一作为工具执行失败，带错误分类返回
+				return nil, asToolError(res.err)
+			}
+
+			structured, err := recorder.content(def.outputType)
+			if err != nil {
+				logger.WithContext(ctx).WithFields(logrus.Fields{
+					"tool_name": toolName,
+					"error":     err.Error(),
+				}).Warn("Discarding structured tool result")
+			}
+			return &ToolResult{
+				Response:          res.response,
+				StructuredContent: structured,
+			}, nil
 		}
+	case <-execCtx.Done():
+		if !errors.Is(execCtx.Err(), context.DeadlineExceeded) {
+			return nil, execCtx.Err()
+		}
+	}
+
+	// 超时：请求级超时与工具执行超时取先到者
+	timeoutErr := &ToolTimeoutError{
+		ToolName: toolName,
+		Elapsed:  time.Since(start),
+		Progress: tracker.Last(),
 	}
-	
-	return "工具执行完成，但没有返回内容", nil
+	if deadline, ok := execCtx.Deadline(); ok {
+		timeoutErr.Timeout = deadline.Sub(start).Round(time.Millisecond)
+	}
+
+	logger.WithContext(ctx).WithFields(logrus.Fields{
+		"tool_name": toolName,
+		"timeout":   timeoutErr.Timeout.String(),
+		"elapsed":   timeoutErr.Elapsed.String(),
+	}).Warn("Tool execution timed out")
+
+	return nil, timeoutErr
+}
+
+// CallTool 调用工具，返回 tools/call 的结果对象
+// 工具不存在或已禁用时返回 nil，由调用者处理
+func (r *GlobalToolRegistry) CallTool(ctx context.Context, toolName string, arguments map[string]interface{}) (map[string]interface{}, error) {
+	if !r.IsToolEnabled(toolName) {
+		return nil, nil
+	}
+
+	result, err := r.Dispatch(ctx, toolName, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按顺序保留处理函数返回的全部内容项
+	var contents []*mcp.Content
+	if result.Response != nil {
+		contents = result.Response.Content
+	}
+	items, err := ContentsToMaps(contents)
+	if err != nil {
+		return nil, fmt.Errorf("tool %s returned invalid content: %w", toolName, err)
+	}
+
+	callResult := map[string]interface{}{
+		"content": items,
+	}
+	if result.StructuredContent != nil {
+		callResult["structuredContent"] = result.StructuredContent
+	}
+
+	return callResult, nil
 }
 
-// ListTools 列出所有注册的工具
+// ListTools 按注册顺序列出所有注册的工具
 func (r *GlobalToolRegistry) ListTools() []string {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
-	
-	tools := make([]string, 0, len(r.handlers))
-	for toolName := range r.handlers {
-		tools = append(tools, toolName)
-	}
+
+	tools := make([]string, len(r.order))
+	copy(tools, r.order)
 	return tools
 }
 
+// ListDefinitions 按注册顺序列出所有工具定义
+func (r *GlobalToolRegistry) ListDefinitions() []*ToolDefinition {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	defs := make([]*ToolDefinition, 0, len(r.order))
+	for _, name := range r.order {
+		defs = append(defs, r.tools[name])
+	}
+	return defs
+}
+
 // ConvertArgumentsToStruct 将map参数转换为结构体
 func ConvertArgumentsToStruct(arguments map[string]interface{}, target interface{}) error {
 	// 先转换为JSON，再反序列化到目标结构体
@@ -100,6 +377,6 @@ func ConvertArgumentsToStruct(arguments map[string]interface{}, target interface
 	if err != nil {
 		return err
 	}
-	
+
 	return json.Unmarshal(jsonData, target)
-}
\ No newline at end of file
+}
@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	mcp "github.com/metoro-io/mcp-golang"
+	"ai-sre/tools/mcp/internal/caller"
+)
+
+func TestDispatchHoldsSlotUntilHandlerReturns(t *testing.T) {
+	// 处理函数不响应取消，超时后继续运行
+	block := make(chan struct{})
+	def := NewTool("test_dispatch_hold_slot", "test tool", func(ctx context.Context, args testTaskArgs) (*mcp.ToolResponse, error) {
+		<-block
+		return mcp.NewToolResponse(mcp.NewTextContent("ok")), nil
+	})
+	if err := GetGlobalRegistry().Register(def); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	var mutex sync.Mutex
+	released := false
+	isReleased := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return released
+	}
+
+	ctx, release := caller.WithSlot(WithExecutionTimeout(context.Background(), 20*time.Millisecond), func() {
+		mutex.Lock()
+		released = true
+		mutex.Unlock()
+	})
+
+	_, err := GetGlobalRegistry().Dispatch(ctx, "test_dispatch_hold_slot", nil)
+	var timeoutErr *ToolTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("Dispatch = %v, want timeout", err)
+	}
+
+	// 调用方结束时处理函数仍在运行，名额不释放
+	release()
+	time.Sleep(20 * time.Millisecond)
+	if isReleased() {
+		t.Fatal("slot released while handler is still running")
+	}
+
+	close(block)
+	deadline := time.Now().Add(5 * time.Second)
+	for !isReleased() {
+		if time.Now().After(deadline) {
+			t.Fatal("slot not released after handler returned")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,313 @@
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// resourceMimeType 资源内容的 MIME 类型，所有资源均以 JSON 返回
+const resourceMimeType = "application/json"
+
+// errResourceNotFound 资源不存在，读取函数返回该错误时按资源不存在处理
+var errResourceNotFound = errors.New("resource not found")
+
+// ResourceReadFunc 读取资源，params 为从 URI 模板中解析出的变量，返回值序列化为 JSON
+type ResourceReadFunc func(ctx context.Context, params map[string]string) (interface{}, error)
+
+// ResourceDefinition 资源定义
+// URITemplate 不含变量时为具体资源，出现在 resources/list 中；含 {变量} 时为资源模板，出现在 resources/templates/list 中
+type ResourceDefinition struct {
+	// URI 或 URI 模板，变量只匹配单个路径段
+	URITemplate string
+
+	// 资源名称
+	Name string
+
+	// 资源描述
+	Description string
+
+	// 对应的工具，工具不存在或被禁用（允许/禁用列表、运行时禁用）时资源不可用；为空时始终可用
+	ToolName string
+
+	// 读取资源
+	Read ResourceReadFunc
+}
+
+// enabled 判断资源是否可用
+func (d *ResourceDefinition) enabled() bool {
+	return d.ToolName == "" || GetGlobalRegistry().IsToolEnabled(d.ToolName)
+}
+
+// isTemplate 判断是否为资源模板
+func (d *ResourceDefinition) isTemplate() bool {
+	return strings.Contains(d.URITemplate, "{")
+}
+
+// match 将 URI 与模板匹配，返回解析出的变量
+func (d *ResourceDefinition) match(uri string) (map[string]string, bool) {
+	params := map[string]string{}
+	template := d.URITemplate
+	for template != "" {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			return params, template == uri
+		}
+		if !strings.HasPrefix(uri, template[:start]) {
+			return nil, false
+		}
+		uri = uri[start:]
+		template = template[start:]
+
+		end := strings.Index(template, "}")
+		if end < 0 {
+			return nil, false
+		}
+		name := template[1:end]
+		template = template[end+1:]
+
+		// 变量取到下一个 "/" 为止，且不能为空
+		valueEnd := strings.Index(uri, "/")
+		if valueEnd < 0 {
+			valueEnd = len(uri)
+		}
+		if valueEnd == 0 {
+			return nil, false
+		}
+		params[name] = uri[:valueEnd]
+		uri = uri[valueEnd:]
+	}
+	return params, uri == ""
+}
+
+// ResourceManager 资源管理器
+// 负责资源的注册、读取，以及定期刷新被订阅的资源并在内容变化时通知
+type ResourceManager struct {
+	resources []*ResourceDefinition
+	watched   map[string]string // 被订阅的资源 URI -> 最近一次内容的摘要
+	onUpdate  func(uri string)
+	mutex     sync.RWMutex
+}
+
+var (
+	// 全局资源管理器实例
+	globalResourceManager = &ResourceManager{
+		watched: make(map[string]string),
+	}
+)
+
+// GetResourceManager 获取全局资源管理器
+func GetResourceManager() *ResourceManager {
+	return globalResourceManager
+}
+
+// RegisterResource 注册资源或资源模板
+func (m *ResourceManager) RegisterResource(def *ResourceDefinition) error {
+	if def == nil || def.URITemplate == "" || def.Read == nil {
+		return fmt.Errorf("resource definition must have a uri and a read function")
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.resources = append(m.resources, def)
+
+	logger.WithFields(logrus.Fields{
+		"uri": def.URITemplate,
+	}).Debug("Registered resource")
+	return nil
+}
+
+// ListResources 列出具体资源，返回 resources/list 中的资源描述
+func (m *ResourceManager) ListResources(ctx context.Context) ([]map[string]interface{}, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	resources := make([]map[string]interface{}, 0)
+	for _, def := range m.resources {
+		if def.isTemplate() || !def.enabled() {
+			continue
+		}
+		resources = append(resources, map[string]interface{}{
+			"uri":         def.URITemplate,
+			"name":        def.Name,
+			"description": def.Description,
+			"mimeType":    resourceMimeType,
+		})
+	}
+	return resources, nil
+}
+
+// ListResourceTemplates 列出资源模板，返回 resources/templates/list 中的模板描述
+func (m *ResourceManager) ListResourceTemplates() []map[string]interface{} {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	templates := make([]map[string]interface{}, 0)
+	for _, def := range m.resources {
+		if !def.isTemplate() || !def.enabled() {
+			continue
+		}
+		templates = append(templates, map[string]interface{}{
+			"uriTemplate": def.URITemplate,
+			"name":        def.Name,
+			"description": def.Description,
+			"mimeType":    resourceMimeType,
+		})
+	}
+	return templates
+}
+
+// ReadResource 读取资源，返回 resources/read 结果中的 contents
+// URI 不匹配任何可用资源或资源不存在时返回 nil，由调用者处理；读取失败返回 *ToolError
+func (m *ResourceManager) ReadResource(ctx context.Context, uri string) ([]map[string]interface{}, error) {
+	def, params := m.find(uri)
+	if def == nil {
+		return nil, nil
+	}
+
+	value, err := def.Read(ctx, params)
+	if errors.Is(err, errResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, WrapToolError(fmt.Sprintf("读取资源 %s 失败", uri), err)
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource %s: %w", uri, err)
+	}
+
+	return []map[string]interface{}{
+		{
+			"uri":      uri,
+			"mimeType": resourceMimeType,
+			"text":     string(data),
+		},
+	}, nil
+}
+
+// HasResource 判断 URI 是否匹配可用的资源或资源模板
+func (m *ResourceManager) HasResource(uri string) bool {
+	def, _ := m.find(uri)
+	return def != nil
+}
+
+// find 查找与 URI 匹配的可用资源定义
+func (m *ResourceManager) find(uri string) (*ResourceDefinition, map[string]string) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, def := range m.resources {
+		if !def.enabled() {
+			continue
+		}
+		if params, ok := def.match(uri); ok {
+			return def, params
+		}
+	}
+	return nil, nil
+}
+
+// SetUpdateHandler 设置资源内容变化时的回调
+func (m *ResourceManager) SetUpdateHandler(handler func(uri string)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onUpdate = handler
+}
+
+// Watch 开始定期刷新资源，内容变化时触发更新回调
+// 首次订阅时在后台读取一次作为比较基准
+func (m *ResourceManager) Watch(uri string) {
+	m.mutex.Lock()
+	if _, exists := m.watched[uri]; exists {
+		m.mutex.Unlock()
+		return
+	}
+	m.watched[uri] = ""
+	m.mutex.Unlock()
+
+	go m.refresh(context.Background(), uri)
+}
+
+// Unwatch 停止刷新资源
+func (m *ResourceManager) Unwatch(uri string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.watched, uri)
+}
+
+// StartRefresh 按间隔刷新被订阅的资源，ctx 取消时退出
+func (m *ResourceManager) StartRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.mutex.RLock()
+				uris := make([]string, 0, len(m.watched))
+				for uri := range m.watched {
+					uris = append(uris, uri)
+				}
+				m.mutex.RUnlock()
+
+				for _, uri := range uris {
+					m.refresh(ctx, uri)
+				}
+			}
+		}
+	}()
+}
+
+// refresh 重新读取资源，内容摘要与上次不同时触发更新回调
+func (m *ResourceManager) refresh(ctx context.Context, uri string) {
+	contents, err := m.ReadResource(ctx, uri)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"uri":   uri,
+			"error": err.Error(),
+		}).Warn("Failed to refresh subscribed resource")
+		return
+	}
+
+	data, err := json.Marshal(contents)
+	if err != nil {
+		return
+	}
+	digest := fmt.Sprintf("%x", sha256.Sum256(data))
+
+	m.mutex.Lock()
+	previous, watched := m.watched[uri]
+	if watched {
+		m.watched[uri] = digest
+	}
+	onUpdate := m.onUpdate
+	m.mutex.Unlock()
+
+	// 首次读取只记录基准；取消订阅后不再通知
+	if !watched || previous == "" || previous == digest || onUpdate == nil {
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"uri": uri,
+	}).Info("Subscribed resource changed")
+	onUpdate(uri)
+}
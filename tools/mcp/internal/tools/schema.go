@@ -0,0 +1,48 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/invopop/jsonschema"
+)
+
+// inputSchemaReflector 参数结构体的 JSON Schema 反射器
+// 配置与 mcp-golang 内部使用的反射器保持一致，保证 stdio 与 HTTP 模式下的 inputSchema 完全相同
+var inputSchemaReflector = jsonschema.Reflector{
+	Anonymous:                  true,
+	AllowAdditionalProperties:  true,
+	RequiredFromJSONSchemaTags: true,
+	DoNotReference:             true,
+	ExpandedStruct:             true,
+}
+
+// GenerateSchemaFromType 根据结构体类型生成 JSON Schema，用于工具的 inputSchema 和 outputSchema
+func GenerateSchemaFromType(structType reflect.Type) (map[string]interface{}, error) {
+	for structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("schema type must be a struct, got %s", structType.Kind())
+	}
+
+	schemaBytes, err := json.Marshal(inputSchemaReflector.ReflectFromType(structType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
+	}
+
+	// MCP 规范要求 inputSchema 和 outputSchema 必须是 object 类型且包含 properties
+	delete(schema, "$schema")
+	schema["type"] = "object"
+	if _, ok := schema["properties"]; !ok {
+		schema["properties"] = map[string]interface{}{}
+	}
+
+	return schema, nil
+}
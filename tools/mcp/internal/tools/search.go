@@ -0,0 +1,199 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	mcp "github.com/metoro-io/mcp-golang"
+)
+
+// 搜索结果数量
+const (
+	defaultSearchLimit = 5
+	maxSearchLimit     = 20
+)
+
+// 匹配项的得分权重：工具名称中的词最重要，其次为分类，描述中的词只作补充
+const (
+	nameMatchScore        = 3
+	categoryMatchScore    = 2
+	descriptionMatchScore = 1
+)
+
+// categoryAliases 查询中出现这些词时视为提到对应分类
+var categoryAliases = map[string][]string{
+	CategoryTKE:         {"tke", "容器服务", "集群"},
+	CategoryCVM:         {"cvm", "云服务器", "主机", "虚拟机"},
+	CategoryCLB:         {"clb", "负载均衡", "监听器", "后端"},
+	CategoryCDB:         {"cdb", "mysql", "数据库", "慢查询", "慢日志"},
+	CategoryVPC:         {"vpc", "网络", "子网", "安全组", "弹性ip", "eip"},
+	CategoryK8s:         {"k8s", "kubernetes", "节点", "pod", "组件"},
+	CategoryDiagnostics: {"诊断", "排查", "健康", "状态", "日志", "异常"},
+}
+
+// SearchToolsArguments search_tools工具的参数结构
+type SearchToolsArguments struct {
+	Query    *string `json:"query" jsonschema:"description=要完成的任务或要查找的工具的自然语言描述(如 查看 TKE 集群节点、MySQL 慢查询),required"`
+	Category *string `json:"category,omitempty" jsonschema:"description=只在指定分类中搜索,enum=tke,enum=cvm,enum=clb,enum=cdb,enum=vpc,enum=k8s,enum=diagnostics"`
+	Limit    *int    `json:"limit,omitempty" jsonschema:"description=返回的工具数量上限(1-20),default=5"`
+}
+
+// SearchToolMatch 搜索匹配的工具
+type SearchToolMatch struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Categories  []string               `json:"categories,omitempty"`
+	Score       int                    `json:"score"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+}
+
+// SearchToolsResult search_tools工具的结果
+type SearchToolsResult struct {
+	Query string            `json:"query"`
+	Total int               `json:"total"`
+	Tools []SearchToolMatch `json:"tools"`
+}
+
+// SearchToolsHandler search_tools工具的处理函数
+// 按关键词对已启用工具的名称、分类和描述打分，返回得分最高的工具描述，客户端无需获取完整的工具列表
+func SearchToolsHandler(ctx context.Context, arguments SearchToolsArguments) (*mcp.ToolResponse, error) {
+	if arguments.Query == nil || strings.TrimSpace(*arguments.Query) == "" {
+		return nil, InvalidArgumentError("参数 query 不能为空")
+	}
+	query := strings.TrimSpace(*arguments.Query)
+
+	limit := defaultSearchLimit
+	if arguments.Limit != nil {
+		limit = *arguments.Limit
+	}
+	if limit < 1 || limit > maxSearchLimit {
+		return nil, InvalidArgumentError("参数 limit 必须在 1 到 %d 之间", maxSearchLimit)
+	}
+
+	category := ""
+	if arguments.Category != nil {
+		category = *arguments.Category
+	}
+
+	registry := GetGlobalRegistry()
+	matches := make([]SearchToolMatch, 0)
+	for _, def := range registry.ListDefinitions() {
+		if def.Name == "search_tools" || !registry.IsToolEnabled(def.Name) {
+			continue
+		}
+		if category != "" && !def.hasCategory(category) {
+			continue
+		}
+		score := scoreTool(def, query)
+		if score == 0 {
+			continue
+		}
+		matches = append(matches, SearchToolMatch{
+			Name:        def.Name,
+			Description: def.Description,
+			Categories:  def.Categories,
+			Score:       score,
+			InputSchema: def.InputSchema,
+		})
+	}
+
+	// 得分相同时保持注册顺序
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	result := &SearchToolsResult{
+		Query: query,
+		Total: len(matches),
+		Tools: matches,
+	}
+	if len(result.Tools) > limit {
+		result.Tools = result.Tools[:limit]
+	}
+	SetStructuredResult(ctx, result)
+
+	content, err := NewJSONContent(result)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResponse(content), nil
+}
+
+// scoreTool 计算工具与查询的匹配得分
+func scoreTool(def *ToolDefinition, query string) int {
+	lowerQuery := strings.ToLower(query)
+	description := strings.ToLower(def.Description)
+
+	nameTokens := make(map[string]bool)
+	for _, token := range strings.Split(def.Name, "_") {
+		nameTokens[token] = true
+	}
+
+	score := 0
+	for _, token := range searchTokens(query) {
+		if nameTokens[token] {
+			score += nameMatchScore
+		}
+		if strings.Contains(description, token) {
+			score += descriptionMatchScore
+		}
+	}
+
+	for _, category := range def.Categories {
+		for _, alias := range categoryAliases[category] {
+			if strings.Contains(lowerQuery, alias) {
+				score += categoryMatchScore
+				break
+			}
+		}
+	}
+	return score
+}
+
+// searchTokens 将查询拆分为去重的关键词
+// 英文和数字按单词切分，中文没有分隔符，连续的汉字按相邻两字切分
+func searchTokens(query string) []string {
+	seen := make(map[string]bool)
+	var tokens []string
+	add := func(token string) {
+		if token != "" && !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+
+	var word, han []rune
+	flush := func() {
+		if len(word) >= 2 {
+			add(string(word))
+		}
+		if len(han) == 1 {
+			add(string(han))
+		}
+		for i := 0; i+1 < len(han); i++ {
+			add(string(han[i : i+2]))
+		}
+		word, han = word[:0], han[:0]
+	}
+
+	for _, r := range strings.ToLower(query) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			if len(word) > 0 {
+				flush()
+			}
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if len(han) > 0 {
+				flush()
+			}
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
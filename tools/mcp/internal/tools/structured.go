@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// structuredResult 记录一次工具调用的类型化结果
+type structuredResult struct {
+	value interface{}
+	mutex sync.Mutex
+}
+
+// structuredResultContextKey 结构化结果记录器在上下文中的键
+type structuredResultContextKey struct{}
+
+// withStructuredResult 为工具调用创建结构化结果记录器
+func withStructuredResult(ctx context.Context) (context.Context, *structuredResult) {
+	recorder := &structuredResult{}
+	return context.WithValue(ctx, structuredResultContextKey{}, recorder), recorder
+}
+
+// SetStructuredResult 记录工具调用的类型化结果
+// 结果类型需与 NewToolWithOutput 声明的输出类型一致，文本内容仍由处理函数正常返回
+func SetStructuredResult(ctx context.Context, result interface{}) {
+	recorder, ok := ctx.Value(structuredResultContextKey{}).(*structuredResult)
+	if !ok {
+		return
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.value = result
+}
+
+// content 将记录的结果转换为 structuredContent
+// 结果类型与 outputType 不一致或没有记录结果时返回 nil；值为 null 的字段被省略，保证内容符合 outputSchema
+func (r *structuredResult) content(outputType reflect.Type) (map[string]interface{}, error) {
+	r.mutex.Lock()
+	value := r.value
+	r.mutex.Unlock()
+
+	if value == nil || outputType == nil {
+		return nil, nil
+	}
+
+	valueType := reflect.TypeOf(value)
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+	if valueType != outputType {
+		return nil, fmt.Errorf("structured result type %s does not match declared output type %s", valueType, outputType)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal structured result: %w", err)
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal structured result: %w", err)
+	}
+
+	return dropNullFields(content).(map[string]interface{}), nil
+}
+
+// dropNullFields 递归删除对象中值为 null 的字段
+func dropNullFields(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = dropNullFields(field)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = dropNullFields(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,11 +14,11 @@ import (
 
 // SystemInfoArguments system_info工具的参数结构
 type SystemInfoArguments struct {
-	Category *string `json:"category" jsonschema:"description=信息类别: all, runtime, memory, environment, process,default=all,enum=all,enum=runtime,enum=memory,enum=environment,enum=process"`
+	Category *string `json:"category" jsonschema:"description=信息类别: all、runtime、memory、environment、process,default=all,enum=all,enum=runtime,enum=memory,enum=environment,enum=process"`
 }
 
 // SystemInfoHandler system_info工具的处理函数
-func SystemInfoHandler(arguments SystemInfoArguments) (*mcp.ToolResponse, error) {
+func SystemInfoHandler(ctx context.Context, arguments SystemInfoArguments) (*mcp.ToolResponse, error) {
 	startTime := time.Now()
 	
 	category := "all"
@@ -51,7 +52,7 @@ func SystemInfoHandler(arguments SystemInfoArguments) (*mcp.ToolResponse, error)
 		output = append(output, "--- Process Information ---")
 		output = append(output, getProcessInfo()...)
 	default:
-		return nil, fmt.Errorf("invalid category: %s. Valid categories: all, runtime, memory, environment, process", category)
+		return nil, InvalidArgumentError("invalid category: %s. Valid categories: all, runtime, memory, environment, process", category)
 	}
 	
 	// 添加执行信息
@@ -0,0 +1,563 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	mcp "github.com/metoro-io/mcp-golang"
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// 异步任务状态
+const (
+	TaskStatusPending   = "pending"
+	TaskStatusRunning   = "running"
+	TaskStatusSucceeded = "succeeded"
+	TaskStatusFailed    = "failed"
+	TaskStatusCancelled = "cancelled"
+)
+
+// 回调投递状态
+const (
+	CallbackStatusPending   = "pending"
+	CallbackStatusDelivered = "delivered"
+	CallbackStatusFailed    = "failed"
+)
+
+// 异步任务限制
+const (
+	maxStoredTasks           = 1000
+	maxStoredTasksPerOwner   = 100
+	callbackAttempts         = 3
+	callbackTimeout          = 10 * time.Second
+	taskAcquireRetryInterval = time.Second
+)
+
+// TaskLimiter 工具调用并发限制器，异步任务执行时按提交者占用名额，避免循环依赖
+type TaskLimiter interface {
+	Acquire(ctx context.Context, key string) (func(), error)
+	SubcallAcquirer(key string, release func()) func(ctx context.Context) (func(), error)
+}
+
+// TaskInfo 异步任务的状态和结果
+type TaskInfo struct {
+	TaskID          string                 `json:"task_id"`
+	ToolName        string                 `json:"tool_name"`
+	Status          string                 `json:"status"`
+	CreatedAt       time.Time              `json:"created_at"`
+	StartedAt       *time.Time             `json:"started_at,omitempty"`
+	FinishedAt      *time.Time             `json:"finished_at,omitempty"`
+	ExpiresAt       *time.Time             `json:"expires_at,omitempty"`
+	Result          map[string]interface{} `json:"result,omitempty"`
+	ErrorCode       string                 `json:"error_code,omitempty"`
+	ErrorMessage    string                 `json:"error_message,omitempty"`
+	ErrorDetails    map[string]interface{} `json:"error_details,omitempty"`
+	ExecutionTimeMs float64                `json:"execution_time_ms,omitempty"`
+	CallbackURL     string                 `json:"callback_url,omitempty"`
+	CallbackStatus  string                 `json:"callback_status,omitempty"`
+	CallbackError   string                 `json:"callback_error,omitempty"`
+	Owner           string                 `json:"-"` // 提交任务的调用方标识，只有提交者可以查询和取消任务
+}
+
+// Finished 任务是否已结束
+func (t *TaskInfo) Finished() bool {
+	return t.Status == TaskStatusSucceeded || t.Status == TaskStatusFailed || t.Status == TaskStatusCancelled
+}
+
+// task 异步任务，字段由 TaskManager 的锁保护
+type task struct {
+	info      TaskInfo
+	arguments map[string]interface{}
+	cancel    context.CancelFunc
+}
+
+// TaskManager 异步任务管理器
+// 任务在后台通过全局工具注册表执行，同时运行的任务数（全局和单个提交者）有上限，超出的任务排队等待；
+// 运行时与同步调用一样按提交者占用工具调用并发名额。结束的任务保留一段时间供查询，
+// 配置了回调地址时结束后将签名的任务结果 POST 到该地址；任务只对提交它的调用方（客户端IP）可见
+type TaskManager struct {
+	mutex          sync.Mutex
+	tasks          map[string]*task
+	retention      time.Duration
+	timeout        time.Duration
+	running        chan struct{}
+	ownerRunning   map[string]chan struct{} // 每个提交者的运行名额
+	maxPerOwner    int
+	limiter        TaskLimiter // nil 表示不限制
+	callbackSecret string
+	callbackHosts  []string
+	httpClient     *http.Client
+	baseCtx        context.Context
+}
+
+var (
+	// 全局异步任务管理器实例
+	globalTaskManager = NewTaskManager(time.Hour, 10*time.Minute, 10, 3, "")
+)
+
+// GetTaskManager 获取全局异步任务管理器
+func GetTaskManager() *TaskManager {
+	return globalTaskManager
+}
+
+// NewTaskManager 创建异步任务管理器
+// retention 为结束的任务保留时间，timeout 为任务执行超时，maxRunning 为同时运行的任务数，
+// maxRunningPerOwner 为单个提交者同时运行的任务数，
+// callbackSecret 为回调请求的 HMAC-SHA256 签名密钥，为空时不允许提交带回调地址的任务
+func NewTaskManager(retention, timeout time.Duration, maxRunning, maxRunningPerOwner int, callbackSecret string) *TaskManager {
+	m := &TaskManager{
+		tasks:      make(map[string]*task),
+		httpClient: newCallbackClient(),
+		baseCtx:    context.Background(),
+	}
+	m.Configure(retention, timeout, maxRunning, maxRunningPerOwner, callbackSecret)
+	return m
+}
+
+// Configure 设置任务保留时间、执行超时、并发数和回调签名密钥，应在提交任务前调用
+func (m *TaskManager) Configure(retention, timeout time.Duration, maxRunning, maxRunningPerOwner int, callbackSecret string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if maxRunning <= 0 {
+		maxRunning = 1
+	}
+	if maxRunningPerOwner <= 0 || maxRunningPerOwner > maxRunning {
+		maxRunningPerOwner = maxRunning
+	}
+	m.retention = retention
+	m.timeout = timeout
+	m.running = make(chan struct{}, maxRunning)
+	m.ownerRunning = make(map[string]chan struct{})
+	m.maxPerOwner = maxRunningPerOwner
+	m.callbackSecret = callbackSecret
+}
+
+// SetLimiter 设置工具调用并发限制器，任务运行时以提交者为键占用名额，batch_call 的子调用同样逐个占用
+func (m *TaskManager) SetLimiter(limiter TaskLimiter) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.limiter = limiter
+}
+
+// SetCallbackAllowedHosts 设置允许作为回调地址的主机，为空时不允许提交带回调地址的任务
+func (m *TaskManager) SetCallbackAllowedHosts(hosts []string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.callbackHosts = hosts
+}
+
+// Start 定期清理过期的任务，ctx 取消时退出并取消所有未结束的任务
+func (m *TaskManager) Start(ctx context.Context) {
+	m.mutex.Lock()
+	m.baseCtx = ctx
+	retention := m.retention
+	m.mutex.Unlock()
+
+	interval := retention / 2
+	if interval > time.Minute || interval <= 0 {
+		interval = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.mutex.Lock()
+				m.expireLocked(time.Now())
+				m.mutex.Unlock()
+			}
+		}
+	}()
+}
+
+// Submit 提交异步工具调用，立即返回任务信息，owner 为提交任务的调用方标识
+// 工具不存在或已禁用返回 not_found 错误，回调地址无效、不被允许或未配置签名密钥返回参数错误，
+// 任务总数或该提交者的任务数达到上限返回 rate_limited 错误
+func (m *TaskManager) Submit(owner, toolName string, arguments map[string]interface{}, callbackURL string) (*TaskInfo, error) {
+	if _, exists := GetGlobalRegistry().GetTool(toolName); !exists || !GetGlobalRegistry().IsToolEnabled(toolName) {
+		return nil, NewToolError(ErrorCategoryNotFound, "工具不存在或已禁用: %s", toolName)
+	}
+	if callbackURL != "" {
+		m.mutex.Lock()
+		allowedHosts, secret := m.callbackHosts, m.callbackSecret
+		m.mutex.Unlock()
+		if secret == "" {
+			return nil, InvalidArgumentError("未配置回调签名密钥 (MCP_TASK_CALLBACK_SECRET)，不支持 callback_url")
+		}
+		if err := checkCallbackURL(callbackURL, allowedHosts); err != nil {
+			return nil, err
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(m.tasks) >= maxStoredTasks || m.ownerTaskCountLocked(owner) >= maxStoredTasksPerOwner {
+		m.expireLocked(time.Now())
+	}
+	if len(m.tasks) >= maxStoredTasks {
+		return nil, NewToolError(ErrorCategoryRateLimited, "异步任务数量已达上限 %d，请稍后重试", maxStoredTasks)
+	}
+	if m.ownerTaskCountLocked(owner) >= maxStoredTasksPerOwner {
+		return nil, NewToolError(ErrorCategoryRateLimited, "当前客户端的异步任务数量已达上限 %d，请稍后重试", maxStoredTasksPerOwner)
+	}
+
+	ctx, cancel := context.WithCancel(m.baseCtx)
+	t := &task{
+		info: TaskInfo{
+			TaskID:      uuid.NewString(),
+			ToolName:    toolName,
+			Status:      TaskStatusPending,
+			CreatedAt:   time.Now().UTC(),
+			CallbackURL: callbackURL,
+			Owner:       owner,
+		},
+		arguments: arguments,
+		cancel:    cancel,
+	}
+	if callbackURL != "" {
+		t.info.CallbackStatus = CallbackStatusPending
+	}
+	m.tasks[t.info.TaskID] = t
+
+	ownerRunning, exists := m.ownerRunning[owner]
+	if !exists {
+		ownerRunning = make(chan struct{}, m.maxPerOwner)
+		m.ownerRunning[owner] = ownerRunning
+	}
+	go m.run(ctx, t, taskSlots{
+		owner:   ownerRunning,
+		running: m.running,
+		limiter: m.limiter,
+	}, m.timeout)
+
+	logger.WithFields(logrus.Fields{
+		"task_id":      t.info.TaskID,
+		"tool_name":    toolName,
+		"has_callback": callbackURL != "",
+	}).Info("Async task submitted")
+
+	info := t.info
+	return &info, nil
+}
+
+// SubmitTask 以上下文中的调用方标识提交异步工具调用，返回任务信息的 JSON 对象
+func (m *TaskManager) SubmitTask(ctx context.Context, toolName string, arguments map[string]interface{}, callbackURL string) (map[string]interface{}, error) {
+	info, err := m.Submit(caller.FromContext(ctx), toolName, arguments, callbackURL)
+	if err != nil {
+		return nil, err
+	}
+	return toJSONObject(info)
+}
+
+// Get 获取任务信息，任务不属于 owner 时与任务不存在相同
+func (m *TaskManager) Get(taskID, owner string) (*TaskInfo, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	t, exists := m.tasks[taskID]
+	if !exists || t.info.Owner != owner {
+		return nil, false
+	}
+	info := t.info
+	return &info, true
+}
+
+// Cancel 取消未结束的任务，已结束的任务保持原状态，返回取消后的任务信息；任务不属于 owner 时与任务不存在相同
+func (m *TaskManager) Cancel(taskID, owner string) (*TaskInfo, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	t, exists := m.tasks[taskID]
+	if !exists || t.info.Owner != owner {
+		return nil, false
+	}
+	if !t.info.Finished() {
+		now := time.Now().UTC()
+		t.info.Status = TaskStatusCancelled
+		t.info.ErrorCode = TaskStatusCancelled
+		t.info.ErrorMessage = "任务已取消"
+		t.info.FinishedAt = &now
+		m.setExpiryLocked(t)
+		t.cancel()
+
+		logger.WithFields(logrus.Fields{
+			"task_id":   taskID,
+			"tool_name": t.info.ToolName,
+		}).Info("Async task cancelled")
+	}
+	info := t.info
+	return &info, true
+}
+
+// ownerTaskCountLocked 统计提交者保存中的任务数，调用方需持有锁
+func (m *TaskManager) ownerTaskCountLocked(owner string) int {
+	count := 0
+	for _, t := range m.tasks {
+		if t.info.Owner == owner {
+			count++
+		}
+	}
+	return count
+}
+
+// taskSlots 任务运行前需要依次占用的名额
+type taskSlots struct {
+	owner   chan struct{} // 提交者的运行名额
+	running chan struct{} // 全局运行名额
+	limiter TaskLimiter   // 工具调用并发名额，nil 表示不限制
+}
+
+// run 在后台执行任务，排队等待运行名额和工具调用并发名额，结束后投递回调
+// 先占用提交者的运行名额，单个提交者排队的任务不会占满全局运行名额
+func (m *TaskManager) run(ctx context.Context, t *task, slots taskSlots, timeout time.Duration) {
+	defer t.cancel()
+
+	for _, slot := range []chan struct{}{slots.owner, slots.running} {
+		select {
+		case slot <- struct{}{}:
+			defer func(slot chan struct{}) { <-slot }(slot)
+		case <-ctx.Done():
+			m.finish(t, nil, ctx.Err(), 0)
+			return
+		}
+	}
+
+	if slots.limiter != nil {
+		release, err := acquireTaskSlot(ctx, slots.limiter, t.info.Owner)
+		if err != nil {
+			m.finish(t, nil, err, 0)
+			return
+		}
+
+		// batch_call 的每个子调用单独获取名额，首个子调用沿用任务已占用的名额
+		ctx = caller.WithAcquirer(ctx, slots.limiter.SubcallAcquirer(t.info.Owner, release))
+		// 工具超时后处理函数仍在运行时，名额保留到其返回
+		ctx, release = caller.WithSlot(ctx, release)
+		defer release()
+	}
+
+	m.mutex.Lock()
+	if t.info.Finished() {
+		m.mutex.Unlock()
+		m.finish(t, nil, nil, 0)
+		return
+	}
+	now := time.Now().UTC()
+	t.info.Status = TaskStatusRunning
+	t.info.StartedAt = &now
+	m.mutex.Unlock()
+
+	if timeout > 0 {
+		ctx = WithExecutionTimeout(ctx, timeout)
+	}
+	ctx = caller.WithIdentity(ctx, t.info.Owner)
+	start := time.Now()
+	result, err := GetGlobalRegistry().CallTool(ctx, t.info.ToolName, t.arguments)
+	m.finish(t, result, err, time.Since(start))
+}
+
+// acquireTaskSlot 以提交者为键获取工具调用并发名额
+// 后台任务没有调用方等待响应，排队已满或排队超时时稍后重试，直到获取成功或任务被取消
+func acquireTaskSlot(ctx context.Context, limiter TaskLimiter, owner string) (func(), error) {
+	for {
+		release, err := limiter.Acquire(ctx, owner)
+		if err == nil {
+			return release, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		timer := time.NewTimer(taskAcquireRetryInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// finish 记录任务结果，任务已被取消时保持取消状态
+func (m *TaskManager) finish(t *task, result map[string]interface{}, err error, elapsed time.Duration) {
+	m.mutex.Lock()
+	if t.info.Status != TaskStatusCancelled {
+		now := time.Now().UTC()
+		t.info.FinishedAt = &now
+		t.info.ExecutionTimeMs = float64(elapsed.Microseconds()) / 1000
+		switch {
+		case err != nil:
+			t.info.Status = TaskStatusFailed
+			t.info.ErrorCode, t.info.ErrorMessage, t.info.ErrorDetails = describeCallError(err)
+		case result == nil:
+			t.info.Status = TaskStatusFailed
+			t.info.ErrorCode = BatchErrorToolNotFound
+			t.info.ErrorMessage = "工具不存在或已禁用: " + t.info.ToolName
+		default:
+			t.info.Status = TaskStatusSucceeded
+			t.info.Result = result
+		}
+		m.setExpiryLocked(t)
+	}
+	info := t.info
+	secret := m.callbackSecret
+	m.mutex.Unlock()
+
+	logger.WithFields(logrus.Fields{
+		"task_id":    info.TaskID,
+		"tool_name":  info.ToolName,
+		"status":     info.Status,
+		"error_code": info.ErrorCode,
+	}).Info("Async task finished")
+
+	if info.CallbackURL == "" {
+		return
+	}
+	callbackErr := deliverTaskCallback(m.httpClient, info, secret)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if callbackErr != nil {
+		t.info.CallbackStatus = CallbackStatusFailed
+		t.info.CallbackError = callbackErr.Error()
+		logger.WithFields(logrus.Fields{
+			"task_id":      info.TaskID,
+			"callback_url": info.CallbackURL,
+			"error":        callbackErr.Error(),
+		}).Warn("Failed to deliver async task callback")
+		return
+	}
+	t.info.CallbackStatus = CallbackStatusDelivered
+}
+
+// setExpiryLocked 设置结束任务的过期时间，调用方需持有锁
+func (m *TaskManager) setExpiryLocked(t *task) {
+	if m.retention > 0 && t.info.FinishedAt != nil {
+		expiresAt := t.info.FinishedAt.Add(m.retention)
+		t.info.ExpiresAt = &expiresAt
+	}
+}
+
+// expireLocked 删除过期的任务及不再有任务的提交者的运行名额，调用方需持有锁
+func (m *TaskManager) expireLocked(now time.Time) {
+	owners := make(map[string]bool)
+	for id, t := range m.tasks {
+		if t.info.ExpiresAt != nil && now.After(*t.info.ExpiresAt) {
+			delete(m.tasks, id)
+			continue
+		}
+		owners[t.info.Owner] = true
+	}
+	for owner := range m.ownerRunning {
+		if !owners[owner] {
+			delete(m.ownerRunning, owner)
+		}
+	}
+}
+
+// deliverTaskCallback 将任务结果 POST 到回调地址，失败时重试
+// X-MCP-Signature 为 "sha256=" 加上对 "{X-MCP-Timestamp}.{请求体}" 的 HMAC-SHA256 十六进制签名
+func deliverTaskCallback(client *http.Client, info TaskInfo, secret string) error {
+	body, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= callbackAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(time.Duration(attempt-1) * time.Second)
+		}
+
+		request, err := http.NewRequest(http.MethodPost, info.CallbackURL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("X-MCP-Task-ID", info.TaskID)
+		request.Header.Set("X-MCP-Timestamp", timestamp)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+		request.Header.Set("X-MCP-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+		response, err := client.Do(request)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		response.Body.Close()
+		if response.StatusCode >= 200 && response.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("callback returned status %d", response.StatusCode)
+	}
+	return lastErr
+}
+
+// TaskIDArguments get_task_result和cancel_task工具的参数结构
+type TaskIDArguments struct {
+	TaskID string `json:"task_id" jsonschema:"description=异步调用返回的任务ID,required"`
+}
+
+// GetTaskResultHandler get_task_result工具的处理函数，只能查询调用方自己提交的任务
+func GetTaskResultHandler(ctx context.Context, arguments TaskIDArguments) (*mcp.ToolResponse, error) {
+	info, exists := GetTaskManager().Get(arguments.TaskID, caller.FromContext(ctx))
+	if !exists {
+		return nil, NewToolError(ErrorCategoryNotFound, "任务不存在或已过期: %s", arguments.TaskID)
+	}
+	return taskResponse(ctx, info)
+}
+
+// CancelTaskHandler cancel_task工具的处理函数，只能取消调用方自己提交的任务
+func CancelTaskHandler(ctx context.Context, arguments TaskIDArguments) (*mcp.ToolResponse, error) {
+	info, exists := GetTaskManager().Cancel(arguments.TaskID, caller.FromContext(ctx))
+	if !exists {
+		return nil, NewToolError(ErrorCategoryNotFound, "任务不存在或已过期: %s", arguments.TaskID)
+	}
+	return taskResponse(ctx, info)
+}
+
+// taskResponse 以任务信息作为工具结果
+func taskResponse(ctx context.Context, info *TaskInfo) (*mcp.ToolResponse, error) {
+	SetStructuredResult(ctx, info)
+
+	content, err := NewJSONContent(info)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResponse(content), nil
+}
+
+// toJSONObject 将结构体转换为 JSON 对象
+func toJSONObject(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
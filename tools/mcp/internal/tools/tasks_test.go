@@ -0,0 +1,215 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	mcp "github.com/metoro-io/mcp-golang"
+	"ai-sre/tools/mcp/internal/caller"
+)
+
+// testTaskArgs 测试工具的参数
+type testTaskArgs struct{}
+
+// registerTestTaskTool 注册测试用的工具，处理函数在 release 关闭或调用被取消前阻塞
+func registerTestTaskTool(t *testing.T, name string, release <-chan struct{}, onCall func(ctx context.Context)) {
+	t.Helper()
+	def := NewTool(name, "test tool", func(ctx context.Context, args testTaskArgs) (*mcp.ToolResponse, error) {
+		if onCall != nil {
+			onCall(ctx)
+		}
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return mcp.NewToolResponse(mcp.NewTextContent("ok")), nil
+	})
+	if err := GetGlobalRegistry().Register(def); err != nil {
+		t.Fatalf("register %s: %v", name, err)
+	}
+}
+
+// waitTaskStatus 等待任务进入指定状态
+func waitTaskStatus(t *testing.T, m *TaskManager, taskID, owner, status string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		info, exists := m.Get(taskID, owner)
+		if !exists {
+			t.Fatalf("task %s not found", taskID)
+		}
+		if info.Status == status {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("task %s status = %s, want %s", taskID, info.Status, status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// fakeTaskLimiter 记录获取名额的键，前 busy 次获取返回繁忙错误
+type fakeTaskLimiter struct {
+	mutex    sync.Mutex
+	busy     int
+	keys     []string
+	released int
+}
+
+func (l *fakeTaskLimiter) Acquire(ctx context.Context, key string) (func(), error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.keys = append(l.keys, key)
+	if l.busy > 0 {
+		l.busy--
+		return nil, errors.New("server busy")
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mutex.Lock()
+			l.released++
+			l.mutex.Unlock()
+		})
+	}, nil
+}
+
+func (l *fakeTaskLimiter) SubcallAcquirer(key string, release func()) func(ctx context.Context) (func(), error) {
+	return func(ctx context.Context) (func(), error) {
+		return release, nil
+	}
+}
+
+func TestTaskManagerLimitsRunningTasksPerOwner(t *testing.T) {
+	release := make(chan struct{})
+	registerTestTaskTool(t, "test_task_running_per_owner", release, nil)
+
+	m := NewTaskManager(time.Hour, time.Minute, 4, 1, "")
+	defer close(release)
+
+	first, err := m.Submit("a", "test_task_running_per_owner", nil, "")
+	if err != nil {
+		t.Fatalf("submit first a: %v", err)
+	}
+	waitTaskStatus(t, m, first.TaskID, "a", TaskStatusRunning)
+
+	second, err := m.Submit("a", "test_task_running_per_owner", nil, "")
+	if err != nil {
+		t.Fatalf("submit second a: %v", err)
+	}
+	other, err := m.Submit("b", "test_task_running_per_owner", nil, "")
+	if err != nil {
+		t.Fatalf("submit b: %v", err)
+	}
+
+	// a 已达到单个提交者的运行上限，b 不受影响
+	waitTaskStatus(t, m, other.TaskID, "b", TaskStatusRunning)
+	if info, _ := m.Get(second.TaskID, "a"); info.Status != TaskStatusPending {
+		t.Fatalf("second a status = %s, want %s", info.Status, TaskStatusPending)
+	}
+
+	// 取消运行中的任务后排队的任务开始运行
+	m.Cancel(first.TaskID, "a")
+	waitTaskStatus(t, m, second.TaskID, "a", TaskStatusRunning)
+}
+
+func TestTaskManagerLimitsStoredTasksPerOwner(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	registerTestTaskTool(t, "test_task_stored_per_owner", release, nil)
+
+	m := NewTaskManager(time.Hour, time.Minute, 10, 10, "")
+	for i := 0; i < maxStoredTasksPerOwner; i++ {
+		if _, err := m.Submit("a", "test_task_stored_per_owner", nil, ""); err != nil {
+			t.Fatalf("submit %d: %v", i, err)
+		}
+	}
+
+	_, err := m.Submit("a", "test_task_stored_per_owner", nil, "")
+	var toolErr *ToolError
+	if !errors.As(err, &toolErr) || toolErr.ErrorCategory() != ErrorCategoryRateLimited {
+		t.Fatalf("submit over limit = %v, want rate_limited", err)
+	}
+
+	if _, err := m.Submit("b", "test_task_stored_per_owner", nil, ""); err != nil {
+		t.Fatalf("submit b: %v", err)
+	}
+}
+
+func TestTaskManagerOwnerIsolation(t *testing.T) {
+	release := make(chan struct{})
+	registerTestTaskTool(t, "test_task_owner_isolation", release, nil)
+
+	m := NewTaskManager(time.Hour, time.Minute, 1, 1, "")
+	defer close(release)
+
+	info, err := m.Submit("a", "test_task_owner_isolation", nil, "")
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if _, exists := m.Get(info.TaskID, "b"); exists {
+		t.Fatal("task is visible to another owner")
+	}
+	if _, exists := m.Cancel(info.TaskID, "b"); exists {
+		t.Fatal("task can be cancelled by another owner")
+	}
+	waitTaskStatus(t, m, info.TaskID, "a", TaskStatusRunning)
+}
+
+func TestTaskManagerTakesLimiterSlotForOwner(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+
+	var mutex sync.Mutex
+	var identity string
+	var hasAcquirer bool
+	registerTestTaskTool(t, "test_task_limiter", release, func(ctx context.Context) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		identity = caller.FromContext(ctx)
+		hasAcquirer = caller.AcquirerFromContext(ctx) != nil
+	})
+
+	// 第一次获取名额繁忙，任务等待后重试
+	limiter := &fakeTaskLimiter{busy: 1}
+	m := NewTaskManager(time.Hour, time.Minute, 1, 1, "")
+	m.SetLimiter(limiter)
+
+	info, err := m.Submit("10.0.0.1", "test_task_limiter", nil, "")
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	waitTaskStatus(t, m, info.TaskID, "10.0.0.1", TaskStatusSucceeded)
+
+	// 名额在任务结束后释放
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		limiter.mutex.Lock()
+		released := limiter.released
+		limiter.mutex.Unlock()
+		if released == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("released = %d, want 1", released)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if len(limiter.keys) != 2 || limiter.keys[0] != "10.0.0.1" || limiter.keys[1] != "10.0.0.1" {
+		t.Fatalf("limiter keys = %v, want two acquisitions for 10.0.0.1", limiter.keys)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if identity != "10.0.0.1" || !hasAcquirer {
+		t.Fatalf("tool context identity = %q, acquirer = %v", identity, hasAcquirer)
+	}
+}
@@ -0,0 +1,252 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"ai-sre/tools/mcp/internal/kubernetes"
+	"ai-sre/tools/mcp/internal/tencentcloud"
+)
+
+// kubeClientTTL Kubernetes 客户端的缓存时间，过期后重新获取 kubeconfig，使集群凭证轮换后能自动生效
+const kubeClientTTL = 10 * time.Minute
+
+// cachedKubeClient 缓存的 Kubernetes 客户端
+type cachedKubeClient struct {
+	client    *kubernetes.Client
+	expiresAt time.Time
+}
+
+// kubeClients 按地域和集群缓存的 Kubernetes 客户端，避免每次读取都重新获取 kubeconfig
+var kubeClients = struct {
+	clients map[string]*cachedKubeClient
+	mutex   sync.Mutex
+}{
+	clients: make(map[string]*cachedKubeClient),
+}
+
+// resourceRegion 校验资源 URI 中的地域，地域无效时按资源不存在处理
+func resourceRegion(params map[string]string) (string, error) {
+	region := params["region"]
+	if !tencentcloud.ValidateRegion(region) {
+		return "", errResourceNotFound
+	}
+	return region, nil
+}
+
+// ResourceDefinitions 腾讯云资源及资源模板
+// 资源内容为对应产品客户端查询结果的 JSON，客户端无需调用工具即可将实时资产信息作为上下文；
+// 每个资源对应查询同类信息的工具，工具被禁用时资源同样不可用
+func (t *TencentCloudTools) ResourceDefinitions() []*ResourceDefinition {
+	return []*ResourceDefinition{
+		{
+			URITemplate: "tencentcloud://regions",
+			ToolName:    "describe_regions",
+			Name:        "可用地域列表",
+			Description: "腾讯云可用地域列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				return tencentcloud.GetAvailableRegions(), nil
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/tke/clusters",
+			ToolName:    "tke_describe_clusters",
+			Name:        "TKE 集群列表",
+			Description: "指定地域的 TKE 普通集群列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				clusters, err := t.tkeClient.DescribeClusters(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				return &DescribeClustersResult{
+					Region:      region,
+					ClusterType: "tke",
+					Clusters:    clusters,
+				}, nil
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/tke/clusters/{id}/instances",
+			ToolName:    "tke_describe_cluster_instances",
+			Name:        "TKE 集群节点列表",
+			Description: "指定 TKE 集群的节点实例列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.tkeClient.DescribeClusterInstances(ctx, region, params["id"], "")
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/cvm/instances",
+			ToolName:    "cvm_describe_instances",
+			Name:        "CVM 实例列表",
+			Description: "指定地域的 CVM 实例列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.cvmClient.DescribeInstances(ctx, region)
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/cvm/instances/{id}",
+			ToolName:    "cvm_describe_instances",
+			Name:        "CVM 实例",
+			Description: "指定 CVM 实例的详细信息",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				result, err := t.cvmClient.DescribeInstances(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				for _, instance := range result.Instances {
+					if instance.InstanceId == params["id"] {
+						return instance, nil
+					}
+				}
+				return nil, errResourceNotFound
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/clb/load_balancers",
+			ToolName:    "clb_describe_load_balancers",
+			Name:        "CLB 实例列表",
+			Description: "指定地域的 CLB 负载均衡实例列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.clbClient.DescribeLoadBalancers(ctx, region)
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/cdb/instances",
+			ToolName:    "cdb_describe_db_instances",
+			Name:        "CDB 实例列表",
+			Description: "指定地域的 CDB (MySQL) 实例列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.cdbClient.DescribeDBInstances(ctx, region)
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/cdb/instances/{id}",
+			ToolName:    "cdb_describe_db_instance_info",
+			Name:        "CDB 实例",
+			Description: "指定 CDB (MySQL) 实例的详细信息",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.cdbClient.DescribeDBInstanceInfo(ctx, region, params["id"])
+			},
+		},
+		{
+			URITemplate: "tencentcloud://{region}/vpc/vpcs",
+			ToolName:    "vpc_describe_vpcs",
+			Name:        "VPC 列表",
+			Description: "指定地域的 VPC 列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				return t.vpcClient.DescribeVpcs(ctx, region)
+			},
+		},
+		{
+			URITemplate: "k8s://{region}/{cluster}/namespaces/{ns}/pods",
+			ToolName:    "tke_describe_cluster_instances",
+			Name:        "Pod 列表",
+			Description: "指定地域 TKE 集群的命名空间下的 Pod 列表",
+			Read: func(ctx context.Context, params map[string]string) (interface{}, error) {
+				region, err := resourceRegion(params)
+				if err != nil {
+					return nil, err
+				}
+				client, err := t.kubeClient(ctx, region, params["cluster"])
+				if err != nil {
+					return nil, err
+				}
+				pods, err := client.ListPods(ctx, params["ns"])
+				if err != nil {
+					evictKubeClient(region, params["cluster"], client, err)
+					return nil, err
+				}
+				return pods, nil
+			},
+		},
+	}
+}
+
+// kubeClient 获取集群的 Kubernetes 客户端
+// kubeconfig 通过 TKE API 获取，配置了 TENCENTCLOUD_USE_INTERNAL 时使用内网地址；客户端缓存 kubeClientTTL 后重新获取
+func (t *TencentCloudTools) kubeClient(ctx context.Context, region, clusterID string) (*kubernetes.Client, error) {
+	key := region + "/" + clusterID
+	now := time.Now()
+
+	kubeClients.mutex.Lock()
+	cached, exists := kubeClients.clients[key]
+	kubeClients.mutex.Unlock()
+	if exists && now.Before(cached.expiresAt) {
+		return cached.client, nil
+	}
+
+	config, err := t.tkeClient.DescribeClusterKubeconfig(ctx, region, clusterID, !t.clientManager.UseInternal())
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewClientFromKubeconfig([]byte(config))
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClients.mutex.Lock()
+	defer kubeClients.mutex.Unlock()
+	for cachedKey, cached := range kubeClients.clients {
+		if !now.Before(cached.expiresAt) {
+			delete(kubeClients.clients, cachedKey)
+		}
+	}
+	kubeClients.clients[key] = &cachedKubeClient{
+		client:    client,
+		expiresAt: now.Add(kubeClientTTL),
+	}
+	return client, nil
+}
+
+// evictKubeClient 访问集群返回 401/403 时移除缓存的客户端，下次读取重新获取 kubeconfig
+func evictKubeClient(region, clusterID string, client *kubernetes.Client, err error) {
+	var apiErr *kubernetes.APIError
+	if !errors.As(err, &apiErr) {
+		return
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized && apiErr.StatusCode != http.StatusForbidden {
+		return
+	}
+
+	key := region + "/" + clusterID
+	kubeClients.mutex.Lock()
+	defer kubeClients.mutex.Unlock()
+	if cached, exists := kubeClients.clients[key]; exists && cached.client == client {
+		delete(kubeClients.clients, key)
+	}
+}
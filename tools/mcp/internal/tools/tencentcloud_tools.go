@@ -232,6 +232,20 @@ type DescribeClustersArgs struct {
 	Format      *string `json:"format,omitempty" jsonschema:"description=输出格式: json或table,default=table"`
 }
 
+// DescribeRegionsResult 地域查询结果
+type DescribeRegionsResult struct {
+	Product string              `json:"product"`
+	Regions []region.RegionInfo `json:"regions"`
+}
+
+// DescribeClustersResult TKE 集群列表查询结果
+type DescribeClustersResult struct {
+	Region      string               `json:"region"`
+	ClusterType string               `json:"cluster_type"`
+	Clusters    []tke.ClusterInfo    `json:"clusters,omitempty"`
+	EKSClusters []tke.EKSClusterInfo `json:"eks_clusters,omitempty"`
+}
+
 // TencentCloudTools 腾讯云工具集
 type TencentCloudTools struct {
 	clientManager *tencentcloud.ClientManager
@@ -319,7 +333,7 @@ func (t *TencentCloudTools) DescribeRegions(ctx context.Context, args DescribeRe
 		product = *args.Product
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":    "describe_regions",
 		"product": product,
 		"format": func() string {
@@ -333,9 +347,13 @@ func (t *TencentCloudTools) DescribeRegions(ctx context.Context, args DescribeRe
 	// 使用地域管理系统查询产品支持的地域信息
 	regions, err := t.regionClient.DescribeRegions(ctx, product)
 	if err != nil {
-		t.logger.WithError(err).Error("地域查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("地域查询失败")
 		return "", fmt.Errorf("查询产品 %s 地域信息失败: %w", product, err)
 	}
+	SetStructuredResult(ctx, &DescribeRegionsResult{
+		Product: product,
+		Regions: regions,
+	})
 	
 	// 根据格式返回结果
 	format := ""
@@ -354,7 +372,7 @@ func (t *TencentCloudTools) DescribeRegions(ctx context.Context, args DescribeRe
 		// 默认使用表格格式
 		return t.regionClient.FormatRegionsAsTable(regions, strings.ToUpper(product)), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -370,7 +388,7 @@ func (t *TencentCloudTools) GetRegion(ctx context.Context, args GetRegionArgs) (
 		regionID = *args.RegionID
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":      "get_region",
 		"product":   product,
 		"region_id": regionID,
@@ -383,15 +401,16 @@ func (t *TencentCloudTools) GetRegion(ctx context.Context, args GetRegionArgs) (
 	}).Info("开始执行特定地域查询")
 	
 	if regionID == "" {
-		return "", fmt.Errorf("地域ID不能为空")
+		return "", InvalidArgumentError("地域ID不能为空")
 	}
 	
 	// 使用地域管理系统查询特定地域信息
 	region, err := t.regionClient.GetRegionByID(ctx, product, regionID)
 	if err != nil {
-		t.logger.WithError(err).Error("特定地域查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("特定地域查询失败")
 		return "", fmt.Errorf("查询产品 %s 地域 %s 信息失败: %w", product, regionID, err)
 	}
+	SetStructuredResult(ctx, region)
 	
 	// 根据格式返回结果
 	format := ""
@@ -414,21 +433,21 @@ func (t *TencentCloudTools) GetRegion(ctx context.Context, args GetRegionArgs) (
 		result += fmt.Sprintf("状态: %s\n", region.RegionState)
 		return result, nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
 // ValidateConnection 验证腾讯云连接
 func (t *TencentCloudTools) ValidateConnection(ctx context.Context) error {
-	t.logger.Info("开始验证腾讯云连接")
+	t.logger.WithContext(ctx).Info("开始验证腾讯云连接")
 	
 	// 验证地域管理权限
 	if err := t.regionClient.ValidatePermissions(ctx); err != nil {
-		t.logger.WithError(err).Error("地域管理权限验证失败")
+		t.logger.WithContext(ctx).WithError(err).Error("地域管理权限验证失败")
 		return fmt.Errorf("地域管理权限验证失败: %w", err)
 	}
 	
-	t.logger.Info("腾讯云连接验证成功")
+	t.logger.WithContext(ctx).Info("腾讯云连接验证成功")
 	return nil
 }
 
@@ -444,7 +463,7 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 		clusterType = strings.ToLower(*args.ClusterType)
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":         "tke_describe_clusters",
 		"region":       region,
 		"cluster_type": clusterType,
@@ -457,7 +476,7 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 	}).Info("开始执行 TKE 集群列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	
 	// 验证 cluster_type 参数
@@ -465,7 +484,7 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 	case "all", "tke", "serverless":
 		// 合法值
 	default:
-		return "", fmt.Errorf("不支持的集群类型: %s，支持的类型: all, tke, serverless", clusterType)
+		return "", InvalidArgumentError("不支持的集群类型: %s，支持的类型: all, tke, serverless", clusterType)
 	}
 	
 	format := ""
@@ -474,14 +493,26 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 	}
 	
 	var resultParts []string
+	structured := &DescribeClustersResult{
+		Region:      region,
+		ClusterType: clusterType,
+	}
+	
+	// 上报进度：all 模式需要依次查询普通集群和 Serverless 集群
+	totalSteps := 1.0
+	if clusterType == "all" {
+		totalSteps = 2
+	}
+	completedSteps := 0.0
 	
 	// 查询普通集群
 	if clusterType == "all" || clusterType == "tke" {
 		clusters, err := t.tkeClient.DescribeClusters(ctx, region)
 		if err != nil {
-			t.logger.WithError(err).Error("TKE 普通集群列表查询失败")
+			t.logger.WithContext(ctx).WithError(err).Error("TKE 普通集群列表查询失败")
 			return "", fmt.Errorf("查询地域 %s 的 TKE 普通集群列表失败: %w", region, err)
 		}
+		structured.Clusters = clusters
 		
 		switch strings.ToLower(format) {
 		case "json":
@@ -493,17 +524,21 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 		case "table", "":
 			resultParts = append(resultParts, t.tkeClient.FormatClustersAsTable(clusters, region))
 		default:
-			return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+			return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 		}
+		
+		completedSteps++
+		ReportProgress(ctx, completedSteps, totalSteps, fmt.Sprintf("已查询 %d 个 TKE 普通集群", len(clusters)))
 	}
 	
 	// 查询 Serverless 集群
 	if clusterType == "all" || clusterType == "serverless" {
 		eksClusters, err := t.tkeClient.DescribeEKSClusters(ctx, region)
 		if err != nil {
-			t.logger.WithError(err).Error("EKS Serverless 集群列表查询失败")
+			t.logger.WithContext(ctx).WithError(err).Error("EKS Serverless 集群列表查询失败")
 			return "", fmt.Errorf("查询地域 %s 的 EKS Serverless 集群列表失败: %w", region, err)
 		}
+		structured.EKSClusters = eksClusters
 		
 		switch strings.ToLower(format) {
 		case "json":
@@ -515,10 +550,14 @@ func (t *TencentCloudTools) DescribeClusters(ctx context.Context, args DescribeC
 		case "table", "":
 			resultParts = append(resultParts, t.tkeClient.FormatEKSClustersAsTable(eksClusters, region))
 		default:
-			return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+			return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 		}
+		
+		completedSteps++
+		ReportProgress(ctx, completedSteps, totalSteps, fmt.Sprintf("已查询 %d 个 EKS Serverless 集群", len(eksClusters)))
 	}
 	
+	SetStructuredResult(ctx, structured)
 	return strings.Join(resultParts, "\n\n"), nil
 }
 
@@ -534,24 +573,25 @@ func (t *TencentCloudTools) GetClusterLevelPrice(ctx context.Context, args GetCl
 		clusterLevel = *args.ClusterLevel
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":          "tke_get_cluster_level_price",
 		"region":        region,
 		"cluster_level": clusterLevel,
 	}).Info("开始执行集群等级价格查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterLevel == "" {
-		return "", fmt.Errorf("集群等级参数不能为空")
+		return "", InvalidArgumentError("集群等级参数不能为空")
 	}
 	
 	info, err := t.tkeClient.GetClusterLevelPrice(ctx, region, clusterLevel)
 	if err != nil {
-		t.logger.WithError(err).Error("集群等级价格查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群等级价格查询失败")
 		return "", fmt.Errorf("查询集群等级 %s 的价格失败: %w", clusterLevel, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -568,7 +608,7 @@ func (t *TencentCloudTools) GetClusterLevelPrice(ctx context.Context, args GetCl
 	case "table", "":
 		return t.tkeClient.FormatClusterLevelPriceAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -589,7 +629,7 @@ func (t *TencentCloudTools) DescribeAddon(ctx context.Context, args DescribeAddo
 		addonName = *args.AddonName
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":       "tke_describe_addon",
 		"region":     region,
 		"cluster_id": clusterID,
@@ -597,17 +637,18 @@ func (t *TencentCloudTools) DescribeAddon(ctx context.Context, args DescribeAddo
 	}).Info("开始执行集群 addon 列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeAddon(ctx, region, clusterID, addonName)
 	if err != nil {
-		t.logger.WithError(err).Error("集群 addon 列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群 addon 列表查询失败")
 		return "", fmt.Errorf("查询集群 %s 的 addon 列表失败: %w", clusterID, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -624,7 +665,7 @@ func (t *TencentCloudTools) DescribeAddon(ctx context.Context, args DescribeAddo
 	case "table", "":
 		return t.tkeClient.FormatAddonListAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -650,7 +691,7 @@ func (t *TencentCloudTools) GetTkeAppChartList(ctx context.Context, args GetTkeA
 		clusterType = *args.ClusterType
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":         "tke_get_app_chart_list",
 		"region":       region,
 		"kind":         kind,
@@ -659,14 +700,15 @@ func (t *TencentCloudTools) GetTkeAppChartList(ctx context.Context, args GetTkeA
 	}).Info("开始执行可安装 addon 列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	
 	info, err := t.tkeClient.GetTkeAppChartList(ctx, region, kind, arch, clusterType)
 	if err != nil {
-		t.logger.WithError(err).Error("可安装 addon 列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("可安装 addon 列表查询失败")
 		return "", fmt.Errorf("查询可安装 addon 列表失败: %w", err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -683,7 +725,7 @@ func (t *TencentCloudTools) GetTkeAppChartList(ctx context.Context, args GetTkeA
 	case "table", "":
 		return t.tkeClient.FormatAppChartListAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -694,20 +736,21 @@ func (t *TencentCloudTools) DescribeImages(ctx context.Context, args DescribeIma
 		region = *args.Region
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "tke_describe_images",
 		"region": region,
 	}).Info("开始执行 OS 镜像列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeImages(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("OS 镜像列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("OS 镜像列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 OS 镜像列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -724,7 +767,7 @@ func (t *TencentCloudTools) DescribeImages(ctx context.Context, args DescribeIma
 	case "table", "":
 		return t.tkeClient.FormatImagesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -735,20 +778,21 @@ func (t *TencentCloudTools) DescribeVersions(ctx context.Context, args DescribeV
 		region = *args.Region
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "tke_describe_versions",
 		"region": region,
 	}).Info("开始执行集群版本列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeVersions(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("集群版本列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群版本列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的集群版本列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -765,7 +809,7 @@ func (t *TencentCloudTools) DescribeVersions(ctx context.Context, args DescribeV
 	case "table", "":
 		return t.tkeClient.FormatVersionsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -781,24 +825,25 @@ func (t *TencentCloudTools) DescribeLogSwitches(ctx context.Context, args Descri
 		clusterID = *args.ClusterID
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":       "tke_describe_log_switches",
 		"region":     region,
 		"cluster_id": clusterID,
 	}).Info("开始执行集群日志开关查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeLogSwitches(ctx, region, clusterID)
 	if err != nil {
-		t.logger.WithError(err).Error("集群日志开关查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群日志开关查询失败")
 		return "", fmt.Errorf("查询集群 %s 的日志开关失败: %w", clusterID, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -815,7 +860,7 @@ func (t *TencentCloudTools) DescribeLogSwitches(ctx context.Context, args Descri
 	case "table", "":
 		return t.tkeClient.FormatLogSwitchesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -836,7 +881,7 @@ func (t *TencentCloudTools) DescribeMasterComponent(ctx context.Context, args De
 		component = *args.Component
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":       "tke_describe_master_component",
 		"region":     region,
 		"cluster_id": clusterID,
@@ -844,17 +889,18 @@ func (t *TencentCloudTools) DescribeMasterComponent(ctx context.Context, args De
 	}).Info("开始执行 master 组件状态查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeMasterComponent(ctx, region, clusterID, component)
 	if err != nil {
-		t.logger.WithError(err).Error("master 组件状态查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("master 组件状态查询失败")
 		return "", fmt.Errorf("查询集群 %s 的 master 组件 %s 状态失败: %w", clusterID, component, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -871,7 +917,7 @@ func (t *TencentCloudTools) DescribeMasterComponent(ctx context.Context, args De
 	case "table", "":
 		return t.tkeClient.FormatMasterComponentAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -892,7 +938,7 @@ func (t *TencentCloudTools) DescribeClusterInstances(ctx context.Context, args D
 		instanceRole = *args.InstanceRole
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":          "tke_describe_cluster_instances",
 		"region":        region,
 		"cluster_id":    clusterID,
@@ -900,17 +946,18 @@ func (t *TencentCloudTools) DescribeClusterInstances(ctx context.Context, args D
 	}).Info("开始执行集群节点实例列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeClusterInstances(ctx, region, clusterID, instanceRole)
 	if err != nil {
-		t.logger.WithError(err).Error("集群节点实例列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群节点实例列表查询失败")
 		return "", fmt.Errorf("查询集群 %s 的节点实例列表失败: %w", clusterID, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -927,7 +974,7 @@ func (t *TencentCloudTools) DescribeClusterInstances(ctx context.Context, args D
 	case "table", "":
 		return t.tkeClient.FormatClusterInstancesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -948,7 +995,7 @@ func (t *TencentCloudTools) DescribeClusterVirtualNode(ctx context.Context, args
 		nodePoolId = *args.NodePoolId
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":         "tke_describe_cluster_virtual_node",
 		"region":       region,
 		"cluster_id":   clusterID,
@@ -956,17 +1003,18 @@ func (t *TencentCloudTools) DescribeClusterVirtualNode(ctx context.Context, args
 	}).Info("开始执行集群超级节点列表查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeClusterVirtualNode(ctx, region, clusterID, nodePoolId)
 	if err != nil {
-		t.logger.WithError(err).Error("集群超级节点列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群超级节点列表查询失败")
 		return "", fmt.Errorf("查询集群 %s 的超级节点列表失败: %w", clusterID, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -983,7 +1031,7 @@ func (t *TencentCloudTools) DescribeClusterVirtualNode(ctx context.Context, args
 	case "table", "":
 		return t.tkeClient.FormatVirtualNodesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -999,24 +1047,25 @@ func (t *TencentCloudTools) DescribeClusterExtraArgs(ctx context.Context, args D
 		clusterID = *args.ClusterID
 	}
 	
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":       "tke_describe_cluster_extra_args",
 		"region":     region,
 		"cluster_id": clusterID,
 	}).Info("开始执行集群自定义参数查询")
 	
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if clusterID == "" {
-		return "", fmt.Errorf("集群ID参数不能为空")
+		return "", InvalidArgumentError("集群ID参数不能为空")
 	}
 	
 	info, err := t.tkeClient.DescribeClusterExtraArgs(ctx, region, clusterID)
 	if err != nil {
-		t.logger.WithError(err).Error("集群自定义参数查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("集群自定义参数查询失败")
 		return "", fmt.Errorf("查询集群 %s 的自定义参数失败: %w", clusterID, err)
 	}
+	SetStructuredResult(ctx, info)
 	
 	format := ""
 	if args.Format != nil {
@@ -1033,7 +1082,7 @@ func (t *TencentCloudTools) DescribeClusterExtraArgs(ctx context.Context, args D
 	case "table", "":
 		return t.tkeClient.FormatClusterExtraArgsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1046,20 +1095,21 @@ func (t *TencentCloudTools) CvmDescribeInstances(ctx context.Context, args CvmDe
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "cvm_describe_instances",
 		"region": region,
 	}).Info("开始执行 CVM 实例列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.cvmClient.DescribeInstances(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("CVM 实例列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CVM 实例列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 CVM 实例列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1072,7 +1122,7 @@ func (t *TencentCloudTools) CvmDescribeInstances(ctx context.Context, args CvmDe
 	case "table", "":
 		return t.cvmClient.FormatInstancesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1083,20 +1133,21 @@ func (t *TencentCloudTools) CvmDescribeInstancesStatus(ctx context.Context, args
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "cvm_describe_instances_status",
 		"region": region,
 	}).Info("开始执行 CVM 实例状态查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.cvmClient.DescribeInstancesStatus(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("CVM 实例状态查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CVM 实例状态查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 CVM 实例状态失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1109,7 +1160,7 @@ func (t *TencentCloudTools) CvmDescribeInstancesStatus(ctx context.Context, args
 	case "table", "":
 		return t.cvmClient.FormatInstancesStatusAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1122,20 +1173,21 @@ func (t *TencentCloudTools) ClbDescribeLoadBalancers(ctx context.Context, args C
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "clb_describe_load_balancers",
 		"region": region,
 	}).Info("开始执行 CLB 实例列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.clbClient.DescribeLoadBalancers(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("CLB 实例列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CLB 实例列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 CLB 实例列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1148,7 +1200,7 @@ func (t *TencentCloudTools) ClbDescribeLoadBalancers(ctx context.Context, args C
 	case "table", "":
 		return t.clbClient.FormatLoadBalancersAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1164,24 +1216,25 @@ func (t *TencentCloudTools) ClbDescribeListeners(ctx context.Context, args ClbDe
 		loadBalancerId = *args.LoadBalancerId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":             "clb_describe_listeners",
 		"region":           region,
 		"load_balancer_id": loadBalancerId,
 	}).Info("开始执行 CLB 监听器列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if loadBalancerId == "" {
-		return "", fmt.Errorf("负载均衡实例ID参数不能为空")
+		return "", InvalidArgumentError("负载均衡实例ID参数不能为空")
 	}
 
 	info, err := t.clbClient.DescribeListeners(ctx, region, loadBalancerId)
 	if err != nil {
-		t.logger.WithError(err).Error("CLB 监听器列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CLB 监听器列表查询失败")
 		return "", fmt.Errorf("查询 CLB %s 的监听器列表失败: %w", loadBalancerId, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1194,7 +1247,7 @@ func (t *TencentCloudTools) ClbDescribeListeners(ctx context.Context, args ClbDe
 	case "table", "":
 		return t.clbClient.FormatListenersAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1210,24 +1263,25 @@ func (t *TencentCloudTools) ClbDescribeTargets(ctx context.Context, args ClbDesc
 		loadBalancerId = *args.LoadBalancerId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":             "clb_describe_targets",
 		"region":           region,
 		"load_balancer_id": loadBalancerId,
 	}).Info("开始执行 CLB 后端服务列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if loadBalancerId == "" {
-		return "", fmt.Errorf("负载均衡实例ID参数不能为空")
+		return "", InvalidArgumentError("负载均衡实例ID参数不能为空")
 	}
 
 	info, err := t.clbClient.DescribeTargets(ctx, region, loadBalancerId, nil)
 	if err != nil {
-		t.logger.WithError(err).Error("CLB 后端服务列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CLB 后端服务列表查询失败")
 		return "", fmt.Errorf("查询 CLB %s 的后端服务列表失败: %w", loadBalancerId, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1240,7 +1294,7 @@ func (t *TencentCloudTools) ClbDescribeTargets(ctx context.Context, args ClbDesc
 	case "table", "":
 		return t.clbClient.FormatTargetsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1256,17 +1310,17 @@ func (t *TencentCloudTools) ClbDescribeTargetHealth(ctx context.Context, args Cl
 		lbIdsStr = *args.LoadBalancerIds
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":              "clb_describe_target_health",
 		"region":            region,
 		"load_balancer_ids": lbIdsStr,
 	}).Info("开始执行 CLB 后端健康状态查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if lbIdsStr == "" {
-		return "", fmt.Errorf("负载均衡实例ID参数不能为空")
+		return "", InvalidArgumentError("负载均衡实例ID参数不能为空")
 	}
 
 	lbIds := strings.Split(lbIdsStr, ",")
@@ -1276,9 +1330,10 @@ func (t *TencentCloudTools) ClbDescribeTargetHealth(ctx context.Context, args Cl
 
 	info, err := t.clbClient.DescribeTargetHealth(ctx, region, lbIds)
 	if err != nil {
-		t.logger.WithError(err).Error("CLB 后端健康状态查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CLB 后端健康状态查询失败")
 		return "", fmt.Errorf("查询 CLB 后端健康状态失败: %w", err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1291,7 +1346,7 @@ func (t *TencentCloudTools) ClbDescribeTargetHealth(ctx context.Context, args Cl
 	case "table", "":
 		return t.clbClient.FormatTargetHealthAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1304,20 +1359,21 @@ func (t *TencentCloudTools) CdbDescribeDBInstances(ctx context.Context, args Cdb
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "cdb_describe_db_instances",
 		"region": region,
 	}).Info("开始执行 CDB 实例列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.cdbClient.DescribeDBInstances(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("CDB 实例列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CDB 实例列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 CDB 实例列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1330,7 +1386,7 @@ func (t *TencentCloudTools) CdbDescribeDBInstances(ctx context.Context, args Cdb
 	case "table", "":
 		return t.cdbClient.FormatDBInstancesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1346,24 +1402,25 @@ func (t *TencentCloudTools) CdbDescribeDBInstanceInfo(ctx context.Context, args
 		instanceId = *args.InstanceId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":        "cdb_describe_db_instance_info",
 		"region":      region,
 		"instance_id": instanceId,
 	}).Info("开始执行 CDB 实例详细信息查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if instanceId == "" {
-		return "", fmt.Errorf("实例ID参数不能为空")
+		return "", InvalidArgumentError("实例ID参数不能为空")
 	}
 
 	info, err := t.cdbClient.DescribeDBInstanceInfo(ctx, region, instanceId)
 	if err != nil {
-		t.logger.WithError(err).Error("CDB 实例详细信息查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CDB 实例详细信息查询失败")
 		return "", fmt.Errorf("查询 CDB 实例 %s 的详细信息失败: %w", instanceId, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1376,7 +1433,7 @@ func (t *TencentCloudTools) CdbDescribeDBInstanceInfo(ctx context.Context, args
 	case "table", "":
 		return t.cdbClient.FormatDBInstanceInfoAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1392,24 +1449,25 @@ func (t *TencentCloudTools) CdbDescribeSlowLogs(ctx context.Context, args CdbDes
 		instanceId = *args.InstanceId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":        "cdb_describe_slow_logs",
 		"region":      region,
 		"instance_id": instanceId,
 	}).Info("开始执行 CDB 慢日志查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if instanceId == "" {
-		return "", fmt.Errorf("实例ID参数不能为空")
+		return "", InvalidArgumentError("实例ID参数不能为空")
 	}
 
 	info, err := t.cdbClient.DescribeSlowLogs(ctx, region, instanceId)
 	if err != nil {
-		t.logger.WithError(err).Error("CDB 慢日志查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CDB 慢日志查询失败")
 		return "", fmt.Errorf("查询 CDB 实例 %s 的慢日志失败: %w", instanceId, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1422,7 +1480,7 @@ func (t *TencentCloudTools) CdbDescribeSlowLogs(ctx context.Context, args CdbDes
 	case "table", "":
 		return t.cdbClient.FormatSlowLogsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1438,17 +1496,17 @@ func (t *TencentCloudTools) CdbDescribeErrorLog(ctx context.Context, args CdbDes
 		instanceId = *args.InstanceId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":        "cdb_describe_error_log",
 		"region":      region,
 		"instance_id": instanceId,
 	}).Info("开始执行 CDB 错误日志查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 	if instanceId == "" {
-		return "", fmt.Errorf("实例ID参数不能为空")
+		return "", InvalidArgumentError("实例ID参数不能为空")
 	}
 
 	// 默认查询最近1小时
@@ -1471,9 +1529,10 @@ func (t *TencentCloudTools) CdbDescribeErrorLog(ctx context.Context, args CdbDes
 
 	info, err := t.cdbClient.DescribeErrorLogData(ctx, region, instanceId, startTime, endTime)
 	if err != nil {
-		t.logger.WithError(err).Error("CDB 错误日志查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("CDB 错误日志查询失败")
 		return "", fmt.Errorf("查询 CDB 实例 %s 的错误日志失败: %w", instanceId, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1486,7 +1545,7 @@ func (t *TencentCloudTools) CdbDescribeErrorLog(ctx context.Context, args CdbDes
 	case "table", "":
 		return t.cdbClient.FormatErrorLogDataAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1499,20 +1558,21 @@ func (t *TencentCloudTools) VpcDescribeVpcs(ctx context.Context, args VpcDescrib
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_vpcs",
 		"region": region,
 	}).Info("开始执行 VPC 列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeVpcs(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("VPC 列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("VPC 列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的 VPC 列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1525,7 +1585,7 @@ func (t *TencentCloudTools) VpcDescribeVpcs(ctx context.Context, args VpcDescrib
 	case "table", "":
 		return t.vpcClient.FormatVpcsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1541,21 +1601,22 @@ func (t *TencentCloudTools) VpcDescribeSubnets(ctx context.Context, args VpcDesc
 		vpcId = *args.VpcId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_subnets",
 		"region": region,
 		"vpc_id": vpcId,
 	}).Info("开始执行子网列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeSubnets(ctx, region, vpcId)
 	if err != nil {
-		t.logger.WithError(err).Error("子网列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("子网列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的子网列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1568,7 +1629,7 @@ func (t *TencentCloudTools) VpcDescribeSubnets(ctx context.Context, args VpcDesc
 	case "table", "":
 		return t.vpcClient.FormatSubnetsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1579,20 +1640,21 @@ func (t *TencentCloudTools) VpcDescribeSecurityGroups(ctx context.Context, args
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_security_groups",
 		"region": region,
 	}).Info("开始执行安全组列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeSecurityGroups(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("安全组列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("安全组列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的安全组列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1605,7 +1667,7 @@ func (t *TencentCloudTools) VpcDescribeSecurityGroups(ctx context.Context, args
 	case "table", "":
 		return t.vpcClient.FormatSecurityGroupsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1621,21 +1683,22 @@ func (t *TencentCloudTools) VpcDescribeNetworkInterfaces(ctx context.Context, ar
 		vpcId = *args.VpcId
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_network_interfaces",
 		"region": region,
 		"vpc_id": vpcId,
 	}).Info("开始执行弹性网卡列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeNetworkInterfaces(ctx, region, vpcId)
 	if err != nil {
-		t.logger.WithError(err).Error("弹性网卡列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("弹性网卡列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的弹性网卡列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1648,7 +1711,7 @@ func (t *TencentCloudTools) VpcDescribeNetworkInterfaces(ctx context.Context, ar
 	case "table", "":
 		return t.vpcClient.FormatNetworkInterfacesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1659,20 +1722,21 @@ func (t *TencentCloudTools) VpcDescribeAddresses(ctx context.Context, args VpcDe
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_addresses",
 		"region": region,
 	}).Info("开始执行弹性公网IP列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeAddresses(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("弹性公网IP列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("弹性公网IP列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的弹性公网IP列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1685,7 +1749,7 @@ func (t *TencentCloudTools) VpcDescribeAddresses(ctx context.Context, args VpcDe
 	case "table", "":
 		return t.vpcClient.FormatAddressesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1696,20 +1760,21 @@ func (t *TencentCloudTools) VpcDescribeBandwidthPackages(ctx context.Context, ar
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_bandwidth_packages",
 		"region": region,
 	}).Info("开始执行带宽包列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeBandwidthPackages(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("带宽包列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("带宽包列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的带宽包列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1722,7 +1787,7 @@ func (t *TencentCloudTools) VpcDescribeBandwidthPackages(ctx context.Context, ar
 	case "table", "":
 		return t.vpcClient.FormatBandwidthPackagesAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1733,20 +1798,21 @@ func (t *TencentCloudTools) VpcDescribeVpcEndPoint(ctx context.Context, args Vpc
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_vpc_endpoint",
 		"region": region,
 	}).Info("开始执行终端节点列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeVpcEndPoint(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("终端节点列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("终端节点列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的终端节点列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1759,7 +1825,7 @@ func (t *TencentCloudTools) VpcDescribeVpcEndPoint(ctx context.Context, args Vpc
 	case "table", "":
 		return t.vpcClient.FormatVpcEndPointAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1770,20 +1836,21 @@ func (t *TencentCloudTools) VpcDescribeVpcEndPointService(ctx context.Context, a
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_vpc_endpoint_service",
 		"region": region,
 	}).Info("开始执行终端节点服务列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeVpcEndPointService(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("终端节点服务列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("终端节点服务列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的终端节点服务列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1796,7 +1863,7 @@ func (t *TencentCloudTools) VpcDescribeVpcEndPointService(ctx context.Context, a
 	case "table", "":
 		return t.vpcClient.FormatVpcEndPointServiceAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
 	}
 }
 
@@ -1807,20 +1874,21 @@ func (t *TencentCloudTools) VpcDescribeVpcPeeringConnections(ctx context.Context
 		region = *args.Region
 	}
 
-	t.logger.WithFields(logrus.Fields{
+	t.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"tool":   "vpc_describe_vpc_peering_connections",
 		"region": region,
 	}).Info("开始执行对等连接列表查询")
 
 	if region == "" {
-		return "", fmt.Errorf("地域参数不能为空")
+		return "", InvalidArgumentError("地域参数不能为空")
 	}
 
 	info, err := t.vpcClient.DescribeVpcPeeringConnections(ctx, region)
 	if err != nil {
-		t.logger.WithError(err).Error("对等连接列表查询失败")
+		t.logger.WithContext(ctx).WithError(err).Error("对等连接列表查询失败")
 		return "", fmt.Errorf("查询地域 %s 的对等连接列表失败: %w", region, err)
 	}
+	SetStructuredResult(ctx, info)
 
 	format := ""
 	if args.Format != nil {
@@ -1833,6 +1901,113 @@ func (t *TencentCloudTools) VpcDescribeVpcPeeringConnections(ctx context.Context
 	case "table", "":
 		return t.vpcClient.FormatVpcPeeringConnectionsAsTable(info), nil
 	default:
-		return "", fmt.Errorf("不支持的输出格式: %s，支持的格式: json, table", format)
+		return "", InvalidArgumentError("不支持的输出格式: %s，支持的格式: json, table", format)
+	}
+}
+
+// completionRegion 校验补全依赖的地域，地域无效时不调用云 API
+func completionRegion(arguments map[string]string) (string, error) {
+	region := arguments["region"]
+	if !tencentcloud.ValidateRegion(region) {
+		return "", InvalidArgumentError("无效的地域: %s", region)
+	}
+	return region, nil
+}
+
+// CompletionSources 腾讯云工具参数的补全来源
+// 地域取自内置的可用地域列表，集群、负载均衡和实例ID按已填写的 region 查询对应产品的实例列表
+func (t *TencentCloudTools) CompletionSources() []*CompletionSource {
+	return []*CompletionSource{
+		{
+			Argument: "region",
+			Fetch: func(ctx context.Context, arguments map[string]string) ([]string, error) {
+				regions := tencentcloud.GetAvailableRegions()
+				values := make([]string, 0, len(regions))
+				for _, r := range regions {
+					values = append(values, r.Region)
+				}
+				return values, nil
+			},
+		},
+		{
+			ToolPrefix: "tke_",
+			Argument:   "cluster_id",
+			DependsOn:  []string{"region"},
+			Fetch: func(ctx context.Context, arguments map[string]string) ([]string, error) {
+				region, err := completionRegion(arguments)
+				if err != nil {
+					return nil, err
+				}
+				clusters, err := t.tkeClient.DescribeClusters(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				values := make([]string, 0, len(clusters))
+				for _, cluster := range clusters {
+					values = append(values, cluster.ClusterID)
+				}
+				return values, nil
+			},
+		},
+		{
+			ToolPrefix: "clb_",
+			Argument:   "load_balancer_id",
+			DependsOn:  []string{"region"},
+			Fetch: func(ctx context.Context, arguments map[string]string) ([]string, error) {
+				region, err := completionRegion(arguments)
+				if err != nil {
+					return nil, err
+				}
+				result, err := t.clbClient.DescribeLoadBalancers(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				values := make([]string, 0, len(result.LoadBalancers))
+				for _, lb := range result.LoadBalancers {
+					values = append(values, lb.LoadBalancerId)
+				}
+				return values, nil
+			},
+		},
+		{
+			ToolPrefix: "cdb_",
+			Argument:   "instance_id",
+			DependsOn:  []string{"region"},
+			Fetch: func(ctx context.Context, arguments map[string]string) ([]string, error) {
+				region, err := completionRegion(arguments)
+				if err != nil {
+					return nil, err
+				}
+				result, err := t.cdbClient.DescribeDBInstances(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				values := make([]string, 0, len(result.Instances))
+				for _, instance := range result.Instances {
+					values = append(values, instance.InstanceId)
+				}
+				return values, nil
+			},
+		},
+		{
+			ToolPrefix: "cvm_",
+			Argument:   "instance_id",
+			DependsOn:  []string{"region"},
+			Fetch: func(ctx context.Context, arguments map[string]string) ([]string, error) {
+				region, err := completionRegion(arguments)
+				if err != nil {
+					return nil, err
+				}
+				result, err := t.cvmClient.DescribeInstances(ctx, region)
+				if err != nil {
+					return nil, err
+				}
+				values := make([]string, 0, len(result.Instances))
+				for _, instance := range result.Instances {
+					values = append(values, instance.InstanceId)
+				}
+				return values, nil
+			},
+		},
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// Completer 参数补全接口，避免循环依赖
+// Complete 返回 completion/complete 结果中的 completion 对象，工具不存在时返回 nil
+type Completer interface {
+	Complete(ctx context.Context, toolName, argument, value string, arguments map[string]string) (map[string]interface{}, error)
+}
+
+// SetCompleter 设置参数补全器，设置后声明 completions 能力
+func (h *MCPMessageHandler) SetCompleter(completer Completer) {
+	h.completer = completer
+}
+
+// handleCompletionComplete 处理 completion/complete 请求
+// 除规范中的 ref/prompt、ref/resource 外，还支持以 ref/tool 引用工具补全其参数
+func (h *MCPMessageHandler) handleCompletionComplete(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	ref, _ := params["ref"].(map[string]interface{})
+	argument, _ := params["argument"].(map[string]interface{})
+	argumentName, _ := argument["name"].(string)
+	argumentValue, _ := argument["value"].(string)
+	if ref == nil || argumentName == "" {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "ref and argument.name are required",
+		})
+	}
+
+	// 2025-06-18 起客户端可在 context.arguments 中附带已填写的其他参数
+	contextArgs := map[string]string{}
+	if completionContext, ok := params["context"].(map[string]interface{}); ok {
+		if arguments, ok := completionContext["arguments"].(map[string]interface{}); ok {
+			for key, value := range arguments {
+				if s, ok := value.(string); ok {
+					contextArgs[key] = s
+				}
+			}
+		}
+	}
+
+	completion := map[string]interface{}{
+		"values":  []string{},
+		"total":   0,
+		"hasMore": false,
+	}
+
+	refType, _ := ref["type"].(string)
+	switch refType {
+	case "ref/tool":
+		toolName, _ := ref["name"].(string)
+		result, err := h.completer.Complete(ctx, toolName, argumentName, argumentValue, contextArgs)
+		var toolErr ToolExecutionError
+		if errors.As(err, &toolErr) {
+			logger.WithFields(logrus.Fields{
+				"tool_name": toolName,
+				"argument":  argumentName,
+				"error":     toolErr.Error(),
+			}).Warn("Argument completion failed")
+			data := toolErr.Details()
+			data["details"] = toolErr.Error()
+			return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", data)
+		}
+		if err != nil {
+			return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+				"details": err.Error(),
+			})
+		}
+		if result == nil {
+			return h.createErrorResponse(jsonRPCMsg, -32602, "Unknown tool", map[string]interface{}{
+				"tool": toolName,
+			})
+		}
+		completion = result
+	case "ref/prompt", "ref/resource":
+		// 提示模板和资源模板参数暂不提供补全
+	default:
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": fmt.Sprintf("unsupported ref type %q", refType),
+		})
+	}
+
+	logger.WithFields(logrus.Fields{
+		"ref":      ref,
+		"argument": argumentName,
+		"total":    completion["total"],
+	}).Debug("Completed argument values")
+
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      jsonRPCMsg["id"],
+		"result": map[string]interface{}{
+			"completion": completion,
+		},
+	}
+
+	responseBytes, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal completion/complete response: %w", err)
+	}
+
+	return responseBytes, nil
+}
@@ -0,0 +1,207 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultEventBufferSize 每个会话默认保留的事件数量
+const defaultEventBufferSize = 100
+
+// subscriberQueueSize 订阅者通道的缓冲大小
+const subscriberQueueSize = 64
+
+// Event 服务器推送给客户端的事件
+type Event struct {
+	ID   string
+	Data []byte
+}
+
+// EventStream 会话事件流
+// 事件按递增ID编号并保存在有界缓冲区中，客户端断线重连时可通过 Last-Event-ID 补发遗漏的事件。
+// 同一时刻只有一个订阅者，避免同一条消息在多个流上重复发送
+type EventStream struct {
+	nextID       uint64
+	buffer       []Event
+	bufferSize   int
+	subscriber   chan Event
+	unsubscribed chan struct{} // 当前订阅结束时关闭，唤醒等待投递的发布者
+	sendTimeout  time.Duration // 订阅者通道已满时发布者等待的时间，0 表示立即断开订阅者
+	closed       bool
+	mutex        sync.Mutex
+	sendMutex    sync.Mutex // 串行化向订阅者通道的发送和关闭，保证事件按发布顺序送达
+}
+
+// NewEventStream 创建事件流，bufferSize 为可补发的历史事件数量
+func NewEventStream(bufferSize int) *EventStream {
+	if bufferSize <= 0 {
+		bufferSize = defaultEventBufferSize
+	}
+	return &EventStream{
+		bufferSize: bufferSize,
+	}
+}
+
+// SetSendTimeout 设置订阅者消费过慢时发布者等待的时间
+// 默认订阅者通道已满时立即断开，由客户端通过 Last-Event-ID 重连补发；
+// 无法补发的流（如旧版SSE传输）设置等待时间后，发布者在通道已满时阻塞等待，超时仍未送达才断开订阅者
+func (s *EventStream) SetSendTimeout(timeout time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.sendTimeout = timeout
+}
+
+// Publish 发布一条事件
+func (s *EventStream) Publish(data []byte) (Event, error) {
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return Event{}, fmt.Errorf("event stream closed")
+	}
+
+	s.nextID++
+	event := Event{
+		ID:   strconv.FormatUint(s.nextID, 10),
+		Data: data,
+	}
+
+	s.buffer = append(s.buffer, event)
+	if len(s.buffer) > s.bufferSize {
+		s.buffer = s.buffer[len(s.buffer)-s.bufferSize:]
+	}
+
+	subscriber, unsubscribed, sendTimeout := s.subscriber, s.unsubscribed, s.sendTimeout
+	if subscriber == nil {
+		s.mutex.Unlock()
+		return event, nil
+	}
+
+	select {
+	case subscriber <- event:
+		s.mutex.Unlock()
+		return event, nil
+	default:
+	}
+	s.mutex.Unlock()
+
+	if sendTimeout > 0 {
+		timer := time.NewTimer(sendTimeout)
+		defer timer.Stop()
+
+		select {
+		case subscriber <- event:
+			return event, nil
+		case <-unsubscribed:
+			// 订阅已结束，事件保留在缓冲区中
+			return event, nil
+		case <-timer.C:
+		}
+	}
+
+	// 订阅者消费过慢时断开，客户端重连后通过 Last-Event-ID 补发
+	s.mutex.Lock()
+	detached := s.subscriber == subscriber
+	if detached {
+		s.detachLocked()
+	}
+	s.mutex.Unlock()
+	if detached {
+		close(subscriber)
+	}
+
+	return event, nil
+}
+
+// detachLocked 结束当前订阅并返回其通道，调用方需持有 mutex
+// 通道需在持有 sendMutex 时关闭，避免与等待投递的发布者冲突
+func (s *EventStream) detachLocked() chan Event {
+	subscriber := s.subscriber
+	if subscriber != nil {
+		close(s.unsubscribed)
+		s.subscriber = nil
+		s.unsubscribed = nil
+	}
+	return subscriber
+}
+
+// closeSubscriber 关闭已结束订阅的通道
+func (s *EventStream) closeSubscriber(subscriber chan Event) {
+	if subscriber == nil {
+		return
+	}
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+	close(subscriber)
+}
+
+// PublishJSON 将消息序列化为JSON后发布
+func (s *EventStream) PublishJSON(message interface{}) (Event, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return s.Publish(data)
+}
+
+// Subscribe 订阅事件流
+// lastEventID 非空时返回缓冲区中该ID之后的事件用于补发；新的订阅会替换已有订阅。
+// 返回的取消函数用于结束订阅
+func (s *EventStream) Subscribe(lastEventID string) ([]Event, <-chan Event, func()) {
+	s.mutex.Lock()
+
+	var replay []Event
+	if lastEventID != "" {
+		if lastID, err := strconv.ParseUint(lastEventID, 10, 64); err == nil {
+			for _, event := range s.buffer {
+				if id, _ := strconv.ParseUint(event.ID, 10, 64); id > lastID {
+					replay = append(replay, event)
+				}
+			}
+		}
+	}
+
+	ch := make(chan Event, subscriberQueueSize)
+	if s.closed {
+		s.mutex.Unlock()
+		close(ch)
+		return replay, ch, func() {}
+	}
+
+	previous := s.detachLocked()
+	s.subscriber = ch
+	s.unsubscribed = make(chan struct{})
+	s.mutex.Unlock()
+	s.closeSubscriber(previous)
+
+	cancel := func() {
+		s.mutex.Lock()
+		var current chan Event
+		if s.subscriber == ch {
+			current = s.detachLocked()
+		}
+		s.mutex.Unlock()
+		s.closeSubscriber(current)
+	}
+
+	return replay, ch, cancel
+}
+
+// Close 关闭事件流并结束当前订阅
+func (s *EventStream) Close() {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return
+	}
+	s.closed = true
+	subscriber := s.detachLocked()
+	s.mutex.Unlock()
+
+	s.closeSubscriber(subscriber)
+}
@@ -0,0 +1,212 @@
+package transport
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BusyError 并发已满时拒绝请求的错误
+type BusyError struct {
+	Reason     string // queue_full 或 queue_timeout
+	Active     int
+	Queued     int
+	RetryAfter time.Duration
+}
+
+// Error 实现 error 接口
+func (e *BusyError) Error() string {
+	return fmt.Sprintf("server busy: %s (active=%d, queued=%d)", e.Reason, e.Active, e.Queued)
+}
+
+// Details 返回放入 JSON-RPC 错误 data 中的详细信息
+func (e *BusyError) Details() map[string]interface{} {
+	return map[string]interface{}{
+		"reason":         e.Reason,
+		"active":         e.Active,
+		"queued":         e.Queued,
+		"retry_after_ms": e.RetryAfter.Milliseconds(),
+	}
+}
+
+// LimiterStats 并发限制器的运行状态
+type LimiterStats struct {
+	Active         int    `json:"active"`
+	Queued         int    `json:"queued"`
+	MaxConcurrent  int    `json:"max_concurrent"`
+	MaxPerClient   int    `json:"max_per_client"`
+	MaxQueued      int    `json:"max_queued"`
+	QueueTimeoutMs int64  `json:"queue_timeout_ms"`
+	Rejected       uint64 `json:"rejected"`
+}
+
+// limiterWaiter 等待队列中的请求
+type limiterWaiter struct {
+	key     string
+	ready   chan struct{}
+	granted bool
+}
+
+// ConcurrencyLimiter 请求并发限制器
+// 同时限制全局并发数和单个键（客户端IP，没有时为会话）的并发数。并发已满时请求进入有界的FIFO等待队列，
+// 放行时按入队顺序选择第一个所属键仍有余量的请求，避免单个客户端的突发请求占满全部并发
+type ConcurrencyLimiter struct {
+	maxConcurrent int
+	maxPerKey     int
+	maxQueued     int
+	queueTimeout  time.Duration
+
+	active   int
+	perKey   map[string]int
+	waiters  *list.List
+	rejected uint64
+	mutex    sync.Mutex
+}
+
+// NewConcurrencyLimiter 创建并发限制器
+// maxPerClient 为单个键（客户端）的并发上限，为 0 时只受全局限制；maxQueued 为 0 时并发已满的请求直接拒绝
+func NewConcurrencyLimiter(maxConcurrent, maxPerClient, maxQueued int, queueTimeout time.Duration) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		maxConcurrent: maxConcurrent,
+		maxPerKey:     maxPerClient,
+		maxQueued:     maxQueued,
+		queueTimeout:  queueTimeout,
+		perKey:        make(map[string]int),
+		waiters:       list.New(),
+	}
+}
+
+// Acquire 获取一个执行名额，返回的函数用于释放名额
+// 排队超时或队列已满时返回 *BusyError，上下文取消时返回上下文错误
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, key string) (func(), error) {
+	l.mutex.Lock()
+
+	waiter := &limiterWaiter{
+		key:   key,
+		ready: make(chan struct{}),
+	}
+	element := l.waiters.PushBack(waiter)
+	l.dispatch()
+
+	if waiter.granted {
+		l.mutex.Unlock()
+		return l.releaseFunc(key), nil
+	}
+
+	if l.waiters.Len() > l.maxQueued {
+		l.waiters.Remove(element)
+		err := l.busyError("queue_full")
+		l.mutex.Unlock()
+		return nil, err
+	}
+	l.mutex.Unlock()
+
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-waiter.ready:
+		return l.releaseFunc(key), nil
+	case <-timer.C:
+		return l.abandon(waiter, element, nil)
+	case <-ctx.Done():
+		return l.abandon(waiter, element, ctx.Err())
+	}
+}
+
+// SubcallAcquirer 返回为子调用获取名额的函数，release 为调用方已持有名额的释放函数
+// 首次获取直接移交已持有的名额，之后按 key 重新排队获取。批量调用据此为每个子调用单独占用名额，
+// 且不会在持有名额的同时等待子调用的名额；释放函数只生效一次，调用方仍可在结束时调用 release
+func (l *ConcurrencyLimiter) SubcallAcquirer(key string, release func()) func(ctx context.Context) (func(), error) {
+	var mutex sync.Mutex
+	held := release
+	return func(ctx context.Context) (func(), error) {
+		mutex.Lock()
+		handover := held
+		held = nil
+		mutex.Unlock()
+
+		if handover != nil {
+			return handover, nil
+		}
+		return l.Acquire(ctx, key)
+	}
+}
+
+// abandon 放弃等待，ctxErr 为 nil 表示排队超时；若名额已在竞争中分配则直接使用该名额
+func (l *ConcurrencyLimiter) abandon(waiter *limiterWaiter, element *list.Element, ctxErr error) (func(), error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if waiter.granted {
+		return l.releaseFunc(waiter.key), nil
+	}
+
+	l.waiters.Remove(element)
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	return nil, l.busyError("queue_timeout")
+}
+
+// releaseFunc 创建只生效一次的释放函数
+func (l *ConcurrencyLimiter) releaseFunc(key string) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mutex.Lock()
+			defer l.mutex.Unlock()
+
+			l.active--
+			if l.perKey[key]--; l.perKey[key] <= 0 {
+				delete(l.perKey, key)
+			}
+			l.dispatch()
+		})
+	}
+}
+
+// dispatch 按入队顺序放行等待中的请求，调用方需持有锁
+func (l *ConcurrencyLimiter) dispatch() {
+	for element := l.waiters.Front(); element != nil && l.active < l.maxConcurrent; {
+		next := element.Next()
+		waiter := element.Value.(*limiterWaiter)
+		if l.maxPerKey <= 0 || l.perKey[waiter.key] < l.maxPerKey {
+			l.waiters.Remove(element)
+			l.active++
+			l.perKey[waiter.key]++
+			waiter.granted = true
+			close(waiter.ready)
+		}
+		element = next
+	}
+}
+
+// busyError 创建服务繁忙错误，调用方需持有锁
+func (l *ConcurrencyLimiter) busyError(reason string) *BusyError {
+	l.rejected++
+	return &BusyError{
+		Reason:     reason,
+		Active:     l.active,
+		Queued:     l.waiters.Len(),
+		RetryAfter: time.Second,
+	}
+}
+
+// Stats 获取当前运行状态
+func (l *ConcurrencyLimiter) Stats() LimiterStats {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return LimiterStats{
+		Active:         l.active,
+		Queued:         l.waiters.Len(),
+		MaxConcurrent:  l.maxConcurrent,
+		MaxPerClient:   l.maxPerKey,
+		MaxQueued:      l.maxQueued,
+		QueueTimeoutMs: l.queueTimeout.Milliseconds(),
+		Rejected:       l.rejected,
+	}
+}
@@ -0,0 +1,162 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitQueued 等待限制器的等待队列达到指定长度
+func waitQueued(t *testing.T, l *ConcurrencyLimiter, queued int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for l.Stats().Queued != queued {
+		if time.Now().After(deadline) {
+			t.Fatalf("queued = %d, want %d", l.Stats().Queued, queued)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestConcurrencyLimiterSkipsKeysAtLimit(t *testing.T) {
+	l := NewConcurrencyLimiter(2, 1, 10, time.Second)
+
+	releaseA, err := l.Acquire(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("acquire a: %v", err)
+	}
+
+	// a 已达到单键上限，排在队首等待
+	grantedA2 := make(chan func(), 1)
+	go func() {
+		release, err := l.Acquire(context.Background(), "a")
+		if err != nil {
+			t.Errorf("acquire second a: %v", err)
+			return
+		}
+		grantedA2 <- release
+	}()
+	waitQueued(t, l, 1)
+
+	// b 入队在 a 之后，放行时跳过仍受单键限制的 a
+	releaseB, err := l.Acquire(context.Background(), "b")
+	if err != nil {
+		t.Fatalf("acquire b: %v", err)
+	}
+	select {
+	case <-grantedA2:
+		t.Fatal("second a granted while first a is active")
+	default:
+	}
+	if stats := l.Stats(); stats.Active != 2 || stats.Queued != 1 {
+		t.Fatalf("stats = %+v, want active 2 queued 1", stats)
+	}
+
+	releaseA()
+	select {
+	case releaseA2 := <-grantedA2:
+		releaseA2()
+	case <-time.After(time.Second):
+		t.Fatal("second a not granted after first a released")
+	}
+	releaseB()
+
+	if stats := l.Stats(); stats.Active != 0 || stats.Queued != 0 {
+		t.Fatalf("stats = %+v, want idle", stats)
+	}
+}
+
+func TestConcurrencyLimiterAbandonKeepsRacingGrant(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 0, 10, time.Second)
+
+	releaseA, err := l.Acquire(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("acquire a: %v", err)
+	}
+
+	// 模拟等待者在取消的同时被放行：名额已分配，abandon 需要沿用该名额而不是丢弃
+	waiter := &limiterWaiter{
+		key:   "b",
+		ready: make(chan struct{}),
+	}
+	l.mutex.Lock()
+	element := l.waiters.PushBack(waiter)
+	l.mutex.Unlock()
+
+	releaseA()
+	if !waiter.granted {
+		t.Fatal("waiter not granted after release")
+	}
+
+	releaseB, err := l.abandon(waiter, element, context.Canceled)
+	if err != nil {
+		t.Fatalf("abandon after grant: %v", err)
+	}
+	if stats := l.Stats(); stats.Active != 1 || stats.Queued != 0 {
+		t.Fatalf("stats = %+v, want active 1 queued 0", stats)
+	}
+
+	releaseB()
+	releaseB()
+	if stats := l.Stats(); stats.Active != 0 {
+		t.Fatalf("active = %d after release, want 0", stats.Active)
+	}
+}
+
+func TestConcurrencyLimiterAbandonBeforeGrant(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 0, 10, 20*time.Millisecond)
+
+	releaseA, err := l.Acquire(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("acquire a: %v", err)
+	}
+	defer releaseA()
+
+	_, err = l.Acquire(context.Background(), "b")
+	var busyErr *BusyError
+	if !errors.As(err, &busyErr) || busyErr.Reason != "queue_timeout" {
+		t.Fatalf("err = %v, want queue_timeout", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.Acquire(ctx, "b"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+
+	if stats := l.Stats(); stats.Active != 1 || stats.Queued != 0 {
+		t.Fatalf("stats = %+v, want active 1 queued 0", stats)
+	}
+}
+
+func TestConcurrencyLimiterQueueFullWithoutQueue(t *testing.T) {
+	l := NewConcurrencyLimiter(1, 0, 0, time.Second)
+
+	releaseA, err := l.Acquire(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("acquire a: %v", err)
+	}
+
+	start := time.Now()
+	_, err = l.Acquire(context.Background(), "b")
+	var busyErr *BusyError
+	if !errors.As(err, &busyErr) || busyErr.Reason != "queue_full" {
+		t.Fatalf("err = %v, want queue_full", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("queue_full took %s, want immediate rejection", elapsed)
+	}
+
+	stats := l.Stats()
+	if stats.Queued != 0 || stats.Rejected != 1 {
+		t.Fatalf("stats = %+v, want queued 0 rejected 1", stats)
+	}
+
+	releaseA()
+	releaseB, err := l.Acquire(context.Background(), "b")
+	if err != nil {
+		t.Fatalf("acquire b after release: %v", err)
+	}
+	releaseB()
+}
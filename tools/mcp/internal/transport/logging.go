@@ -0,0 +1,164 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// sessionLoggerName notifications/message 中的日志来源名称
+const sessionLoggerName = "ai-sre-mcp-server"
+
+// mcpLogLevels MCP 日志级别，按严重程度从低到高排列（RFC 5424）
+var mcpLogLevels = []string{"debug", "info", "notice", "warning", "error", "critical", "alert", "emergency"}
+
+// logLevelSeverity 获取日志级别的严重程度，未知级别返回 -1
+func logLevelSeverity(level string) int {
+	for i, l := range mcpLogLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// mcpLogLevel 将 logrus 日志级别映射为 MCP 日志级别
+func mcpLogLevel(level logrus.Level) string {
+	switch level {
+	case logrus.PanicLevel:
+		return "alert"
+	case logrus.FatalLevel:
+		return "critical"
+	case logrus.ErrorLevel:
+		return "error"
+	case logrus.WarnLevel:
+		return "warning"
+	case logrus.InfoLevel:
+		return "info"
+	default:
+		return "debug"
+	}
+}
+
+// sessionLogForwarder 将一次工具调用期间的日志以 notifications/message 转发给会话
+// 日志级别低于会话通过 logging/setLevel 设置的级别时不转发
+type sessionLogForwarder struct {
+	session   *Session
+	toolName  string
+	requestID interface{}
+	stopped   bool
+	mutex     sync.Mutex
+}
+
+// newSessionLogForwarder 创建绑定到工具调用的日志转发器
+func newSessionLogForwarder(session *Session, toolName string, requestID interface{}) *sessionLogForwarder {
+	return &sessionLogForwarder{
+		session:   session,
+		toolName:  toolName,
+		requestID: requestID,
+	}
+}
+
+// forward 转发一条日志，请求结束后不再转发
+func (f *sessionLogForwarder) forward(entry *logrus.Entry) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.stopped {
+		return
+	}
+
+	level := mcpLogLevel(entry.Level)
+	if logLevelSeverity(level) < logLevelSeverity(f.session.GetLogLevel()) {
+		return
+	}
+
+	data := map[string]interface{}{
+		"message":    entry.Message,
+		"tool":       f.toolName,
+		"request_id": f.requestID,
+	}
+	if len(entry.Data) > 0 {
+		data["fields"] = logFieldsToJSON(entry.Data)
+	}
+
+	if err := f.session.Notify("notifications/message", map[string]interface{}{
+		"level":  level,
+		"logger": sessionLoggerName,
+		"data":   data,
+	}); err != nil {
+		// 不绑定上下文，避免转发失败的日志再次触发转发
+		logger.WithFields(logrus.Fields{
+			"session_id": f.session.ID,
+			"error":      err.Error(),
+		}).Warn("Failed to forward log message to session")
+	}
+}
+
+// stop 停止转发日志，请求响应发出前调用
+func (f *sessionLogForwarder) stop() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.stopped = true
+}
+
+// logFieldsToJSON 将日志字段转换为可序列化的值，error 等无法直接序列化的值转换为字符串
+func logFieldsToJSON(fields logrus.Fields) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		switch v := value.(type) {
+		case error:
+			result[key] = v.Error()
+		default:
+			if _, err := json.Marshal(v); err != nil {
+				result[key] = fmt.Sprint(v)
+			} else {
+				result[key] = v
+			}
+		}
+	}
+	return result
+}
+
+// handleLoggingSetLevel 处理 logging/setLevel 请求，设置当前会话接收日志的最低级别
+func (h *MCPMessageHandler) handleLoggingSetLevel(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	level, _ := params["level"].(string)
+	if logLevelSeverity(level) < 0 {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": fmt.Sprintf("invalid log level %q", level),
+			"levels":  mcpLogLevels,
+		})
+	}
+
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+			"details": "no session for request",
+		})
+	}
+	session.SetLogLevel(level)
+
+	logger.WithFields(logrus.Fields{
+		"session_id": session.ID,
+		"level":      level,
+	}).Info("Session log level updated")
+
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      jsonRPCMsg["id"],
+		"result":  map[string]interface{}{},
+	}
+
+	responseBytes, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal logging/setLevel response: %w", err)
+	}
+
+	return responseBytes, nil
+}
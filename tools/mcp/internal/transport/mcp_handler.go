@@ -1,13 +1,18 @@
 package transport
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	mcp "github.com/metoro-io/mcp-golang"
 	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/internal/progress"
 	"ai-sre/tools/mcp/pkg/logger"
 )
 
@@ -16,20 +21,47 @@ type MCPMessageHandler struct {
 	server       *mcp.Server
 	mcpServer    MCPServerInterface // 添加对MCPServer的引用
 	toolRegistry ToolRegistry       // 工具注册表引用（统一处理所有工具调用）
-	initialized  bool
-	initMux      sync.RWMutex
-	capabilities map[string]interface{}
+	sessions     *SessionManager    // 会话管理器（初始化状态、协商结果按会话保存）
+	requestTimeout time.Duration    // 单个请求的超时时间，0 表示不限制
+	limiter      *ConcurrencyLimiter // 工具调用并发限制器，nil 表示不限制
+	loggingEnabled bool             // 是否声明 logging 能力并向会话转发工具调用日志
+	completer    Completer          // 参数补全器，nil 表示不支持 completion/complete
+	tasks        TaskSubmitter      // 异步任务提交器，nil 表示不支持异步调用
+	resources    ResourceProvider   // 资源提供者，nil 时资源列表为空
+	subscriptions *resourceSubscriptions // 资源订阅关系
+	prompts      PromptProvider     // 提示模板提供者，nil 时提示模板列表为空
+	toolsPageSize int               // tools/list 每页的工具数量，0 表示不分页
 }
 
 // MCPServerInterface 定义MCPServer接口，避免循环依赖
 type MCPServerInterface interface {
 	GetRegisteredTools() []string
 	GetToolCount() int
+	GetToolInfo(toolName string) (map[string]interface{}, bool)
 }
 
+// TimeoutError 工具超时错误接口，避免循环依赖
+type TimeoutError interface {
+	error
+	IsTimeout() bool
+	Details() map[string]interface{}
+}
+
+// ToolExecutionError 工具执行失败错误接口，避免循环依赖
+// 工具执行失败以 isError 结果返回，JSON-RPC 错误只用于协议层面的问题
+type ToolExecutionError interface {
+	error
+	ErrorCategory() string
+	Details() map[string]interface{}
+}
+
+// errUnknownTool 请求的工具未注册
+var errUnknownTool = errors.New("unknown tool")
+
 // ToolRegistry 工具注册表接口，避免循环依赖
+// CallTool 返回 tools/call 的结果对象（content、structuredContent 等），工具不存在时返回 nil
 type ToolRegistry interface {
-	CallTool(ctx context.Context, toolName string, arguments map[string]interface{}) (string, error)
+	CallTool(ctx context.Context, toolName string, arguments map[string]interface{}) (map[string]interface{}, error)
 }
 
 // NewMCPMessageHandler 创建MCP消息处理器
@@ -37,8 +69,8 @@ func NewMCPMessageHandler(server *mcp.Server) *MCPMessageHandler {
 	return &MCPMessageHandler{
 		server:       server,
 		mcpServer:    nil, // 将在SetMCPServer中设置
-		initialized:  false,
-		capabilities: make(map[string]interface{}),
+		sessions:     NewSessionManager(30*time.Minute, defaultEventBufferSize, defaultMaxSessions, defaultMaxSessionsPerClient),
+		subscriptions: newResourceSubscriptions(),
 	}
 }
 
@@ -47,12 +79,74 @@ func (h *MCPMessageHandler) SetMCPServer(mcpServer MCPServerInterface) {
 	h.mcpServer = mcpServer
 }
 
+// SetSessionManager 设置会话管理器
+func (h *MCPMessageHandler) SetSessionManager(sessions *SessionManager) {
+	h.sessions = sessions
+}
+
+// Sessions 获取会话管理器
+func (h *MCPMessageHandler) Sessions() *SessionManager {
+	return h.sessions
+}
+
+// SetRequestTimeout 设置单个请求的超时时间
+func (h *MCPMessageHandler) SetRequestTimeout(timeout time.Duration) {
+	h.requestTimeout = timeout
+}
+
+// RequestTimeout 获取单个请求的超时时间
+func (h *MCPMessageHandler) RequestTimeout() time.Duration {
+	return h.requestTimeout
+}
+
+// SetConcurrencyLimiter 设置工具调用并发限制器
+func (h *MCPMessageHandler) SetConcurrencyLimiter(limiter *ConcurrencyLimiter) {
+	h.limiter = limiter
+}
+
+// Limiter 获取工具调用并发限制器，未设置时返回 nil
+func (h *MCPMessageHandler) Limiter() *ConcurrencyLimiter {
+	return h.limiter
+}
+
+// SetLoggingEnabled 设置是否启用 MCP 日志能力
+func (h *MCPMessageHandler) SetLoggingEnabled(enabled bool) {
+	h.loggingEnabled = enabled
+}
+
 // SetToolRegistry 设置工具注册表引用
 func (h *MCPMessageHandler) SetToolRegistry(registry ToolRegistry) {
 	h.toolRegistry = registry
 }
 
+// NotifyToolListChanged 向所有已初始化的会话推送 notifications/tools/list_changed
+func (h *MCPMessageHandler) NotifyToolListChanged() {
+	if h.sessions == nil {
+		return
+	}
+
+	notified := 0
+	for _, session := range h.sessions.List() {
+		if !session.IsInitialized() || session.Context().Err() != nil {
+			continue
+		}
+		if err := session.Notify("notifications/tools/list_changed", nil); err != nil {
+			logger.WithFields(logrus.Fields{
+				"session_id": session.ID,
+				"error":      err.Error(),
+			}).Warn("Failed to send tools list changed notification")
+			continue
+		}
+		notified++
+	}
+
+	logger.WithFields(logrus.Fields{
+		"notified_sessions": notified,
+	}).Info("Sent tools list changed notification")
+}
+
 // HandleMessage 处理MCP消息
+// 支持单条消息和JSON-RPC批量请求，通知及客户端响应消息不产生输出，此时返回 nil
 func (h *MCPMessageHandler) HandleMessage(ctx context.Context, message []byte) ([]byte, error) {
 	logger.WithFields(logrus.Fields{
 		"message_size": len(message),
@@ -65,14 +159,86 @@ func (h *MCPMessageHandler) HandleMessage(ctx context.Context, message []byte) (
 		}).Debug("Full MCP message content")
 	}
 
+	trimmed := bytes.TrimSpace(message)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return h.handleBatch(ctx, trimmed)
+	}
+
+	return h.handleSingleMessage(ctx, trimmed, false)
+}
+
+// handleBatch 处理JSON-RPC批量请求
+// 批量中的消息并发处理，响应按请求顺序返回；全部为通知时没有响应
+func (h *MCPMessageHandler) handleBatch(ctx context.Context, message []byte) ([]byte, error) {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(message, &batch); err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warn("Failed to parse JSON-RPC batch")
+		return h.createErrorResponse(nil, -32700, "Parse error", nil)
+	}
+
+	if len(batch) == 0 {
+		return h.createErrorResponse(nil, -32600, "Invalid request", map[string]interface{}{
+			"details": "empty batch",
+		})
+	}
+
+	logger.WithFields(logrus.Fields{
+		"batch_size": len(batch),
+	}).Debug("Processing JSON-RPC batch")
+
+	responses := make([][]byte, len(batch))
+	errs := make([]error, len(batch))
+
+	var wg sync.WaitGroup
+	for i, item := range batch {
+		wg.Add(1)
+		go func(i int, item json.RawMessage) {
+			defer wg.Done()
+			responses[i], errs[i] = h.handleSingleMessage(ctx, bytes.TrimSpace(item), true)
+		}(i, item)
+	}
+	wg.Wait()
+
+	results := make([]json.RawMessage, 0, len(batch))
+	for i, response := range responses {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if response != nil {
+			results = append(results, response)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	responseBytes, err := json.Marshal(results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch response: %w", err)
+	}
+	return responseBytes, nil
+}
+
+// handleSingleMessage 处理单条JSON-RPC消息
+func (h *MCPMessageHandler) handleSingleMessage(ctx context.Context, message []byte, inBatch bool) ([]byte, error) {
 	// 解析JSON-RPC消息
 	var jsonRPCMsg map[string]interface{}
 	if err := json.Unmarshal(message, &jsonRPCMsg); err != nil {
+		if json.Valid(message) {
+			// 合法JSON但不是对象
+			return h.createErrorResponse(nil, -32600, "Invalid request", nil)
+		}
 		logger.WithFields(logrus.Fields{
 			"error":   err.Error(),
 			"message": string(message),
-		}).Error("Failed to parse JSON-RPC message")
-		return nil, fmt.Errorf("invalid JSON-RPC message: %w", err)
+		}).Warn("Failed to parse JSON-RPC message")
+		return h.createErrorResponse(nil, -32700, "Parse error", nil)
+	}
+	if jsonRPCMsg == nil {
+		return h.createErrorResponse(nil, -32600, "Invalid request", nil)
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -81,22 +247,95 @@ func (h *MCPMessageHandler) HandleMessage(ctx context.Context, message []byte) (
 		"method":  jsonRPCMsg["method"],
 	}).Debug("Parsed JSON-RPC message")
 
+	// id 只能是字符串、数字或null
+	id, hasID := jsonRPCMsg["id"]
+	switch id.(type) {
+	case nil, string, float64:
+	default:
+		return h.createErrorResponse(nil, -32600, "Invalid request", map[string]interface{}{
+			"details": "id must be a string, number or null",
+		})
+	}
+
+	if version, _ := jsonRPCMsg["jsonrpc"].(string); version != "2.0" {
+		return h.createErrorResponse(jsonRPCMsg, -32600, "Invalid request", map[string]interface{}{
+			"details": "jsonrpc must be \"2.0\"",
+		})
+	}
+
+	method, hasMethod := jsonRPCMsg["method"].(string)
+	if !hasMethod {
+		// 客户端对服务器请求的响应，目前服务器不会发起请求，直接忽略
+		if _, isResult := jsonRPCMsg["result"]; isResult && hasID {
+			return nil, nil
+		}
+		if _, isError := jsonRPCMsg["error"]; isError && hasID {
+			return nil, nil
+		}
+
+		logger.WithFields(logrus.Fields{
+			"jsonrpc_msg": jsonRPCMsg,
+		}).Warn("Invalid or missing method in request")
+		return h.createErrorResponse(jsonRPCMsg, -32600, "Invalid request", map[string]interface{}{
+			"details": "method must be a string",
+		})
+	}
+
+	// 通知消息（没有id）不需要响应
+	if !hasID {
+		h.handleNotification(ctx, method, jsonRPCMsg)
+		return nil, nil
+	}
+
 	// 检查是否是初始化请求
-	if method, ok := jsonRPCMsg["method"].(string); ok && method == "initialize" {
+	if method == "initialize" {
+		if inBatch {
+			return h.createErrorResponse(jsonRPCMsg, -32600, "Invalid request", map[string]interface{}{
+				"details": "initialize must not be part of a batch",
+			})
+		}
 		logger.Debug("Handling initialize request")
 		return h.HandleInitialize(ctx, message)
 	}
 
-	// 检查是否已初始化
-	if !h.IsInitialized() {
+	// 检查当前会话是否已初始化
+	if session := SessionFromContext(ctx); session == nil || !session.IsInitialized() {
 		logger.WithFields(logrus.Fields{
-			"method": jsonRPCMsg["method"],
+			"method": method,
 		}).Warn("Received request before initialization")
 		return h.createErrorResponse(jsonRPCMsg, -32002, "Server not initialized", nil)
 	}
 
+	// 登记进行中的请求：客户端断开、会话结束或收到 notifications/cancelled 时取消
+	requestCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if session := SessionFromContext(ctx); session != nil {
+		stop := context.AfterFunc(session.Context(), cancel)
+		defer stop()
+		untrack := session.TrackRequest(id, cancel)
+		defer untrack()
+	}
+
+	// 请求级超时，超时后返回结构化的超时错误而不是一直挂起
+	if h.requestTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+		requestCtx, cancelTimeout = context.WithTimeout(requestCtx, h.requestTimeout)
+		defer cancelTimeout()
+	}
+
 	// 处理其他MCP消息
-	return h.handleMCPMessage(ctx, jsonRPCMsg, message)
+	response, err := h.handleMCPMessage(requestCtx, jsonRPCMsg, message)
+
+	// 已取消的请求不再发送响应
+	if errors.Is(requestCtx.Err(), context.Canceled) {
+		logger.WithFields(logrus.Fields{
+			"method": method,
+			"id":     id,
+		}).Info("Request cancelled, response discarded")
+		return nil, nil
+	}
+
+	return response, err
 }
 
 // HandleInitialize 处理初始化请求
@@ -134,31 +373,54 @@ func (h *MCPMessageHandler) HandleInitialize(ctx context.Context, message []byte
 		"client_capabilities": initRequest.Params.Capabilities,
 	}).Info("Received initialize request")
 
-	// 标记为已初始化
-	h.initMux.Lock()
-	h.initialized = true
-	h.capabilities = initRequest.Params.Capabilities
-	h.initMux.Unlock()
+	// 协商协议版本：取不高于客户端请求版本的最高支持版本
+	protocolVersion := NegotiateProtocolVersion(initRequest.Params.ProtocolVersion)
+
+	// 在当前会话上记录协商结果
+	if session := SessionFromContext(ctx); session != nil {
+		session.Initialize(
+			protocolVersion,
+			initRequest.Params.Capabilities,
+			initRequest.Params.ClientInfo.Name,
+			initRequest.Params.ClientInfo.Version,
+		)
+		logger.WithFields(logrus.Fields{
+			"session_id":       session.ID,
+			"protocol_version": protocolVersion,
+		}).Debug("Session marked as initialized")
+	}
 
-	logger.Debug("Server marked as initialized")
+	capabilities := map[string]interface{}{
+		"tools": map[string]interface{}{
+			"listChanged": true,
+		},
+		"resources": map[string]interface{}{
+			"listChanged": false,
+		},
+		"prompts": map[string]interface{}{
+			"listChanged": false,
+		},
+	}
+	if h.resources != nil {
+		capabilities["resources"] = map[string]interface{}{
+			"subscribe":   true,
+			"listChanged": false,
+		}
+	}
+	if h.loggingEnabled {
+		capabilities["logging"] = map[string]interface{}{}
+	}
+	if h.completer != nil {
+		capabilities["completions"] = map[string]interface{}{}
+	}
 
 	// 创建初始化响应
 	response := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"id":      initRequest.ID,
 		"result": map[string]interface{}{
-			"protocolVersion": "2024-11-05",
-			"capabilities": map[string]interface{}{
-				"tools": map[string]interface{}{
-					"listChanged": false,
-				},
-				"resources": map[string]interface{}{
-					"listChanged": false,
-				},
-				"prompts": map[string]interface{}{
-					"listChanged": false,
-				},
-			},
+			"protocolVersion": protocolVersion,
+			"capabilities":    capabilities,
 			"serverInfo": map[string]interface{}{
 				"name":    "ai-sre-mcp-server",
 				"version": "1.0.0",
@@ -188,13 +450,6 @@ func (h *MCPMessageHandler) HandleInitialize(ctx context.Context, message []byte
 	return responseBytes, nil
 }
 
-// IsInitialized 检查是否已初始化
-func (h *MCPMessageHandler) IsInitialized() bool {
-	h.initMux.RLock()
-	defer h.initMux.RUnlock()
-	return h.initialized
-}
-
 // handleMCPMessage 处理具体的MCP消息
 func (h *MCPMessageHandler) handleMCPMessage(ctx context.Context, jsonRPCMsg map[string]interface{}, rawMessage []byte) ([]byte, error) {
 	method, ok := jsonRPCMsg["method"].(string)
@@ -213,16 +468,45 @@ func (h *MCPMessageHandler) handleMCPMessage(ctx context.Context, jsonRPCMsg map
 	switch method {
 	case "tools/list":
 		logger.Debug("Routing to tools/list handler")
-		return h.handleToolsList(jsonRPCMsg)
+		return h.handleToolsList(ctx, jsonRPCMsg)
 	case "tools/call":
 		logger.Debug("Routing to tools/call handler")
 		return h.handleToolsCall(ctx, jsonRPCMsg)
 	case "resources/list":
 		logger.Debug("Routing to resources/list handler")
-		return h.handleResourcesList(jsonRPCMsg)
+		return h.handleResourcesList(ctx, jsonRPCMsg)
+	case "resources/templates/list":
+		logger.Debug("Routing to resources/templates/list handler")
+		return h.handleResourceTemplatesList(jsonRPCMsg)
+	case "resources/read":
+		logger.Debug("Routing to resources/read handler")
+		return h.handleResourcesRead(ctx, jsonRPCMsg)
+	case "resources/subscribe", "resources/unsubscribe":
+		if h.resources == nil {
+			return h.createErrorResponse(jsonRPCMsg, -32601, "Method not found", nil)
+		}
+		if method == "resources/subscribe" {
+			return h.handleResourcesSubscribe(ctx, jsonRPCMsg)
+		}
+		return h.handleResourcesUnsubscribe(ctx, jsonRPCMsg)
 	case "prompts/list":
 		logger.Debug("Routing to prompts/list handler")
 		return h.handlePromptsList(jsonRPCMsg)
+	case "prompts/get":
+		logger.Debug("Routing to prompts/get handler")
+		return h.handlePromptsGet(jsonRPCMsg)
+	case "logging/setLevel":
+		if !h.loggingEnabled {
+			return h.createErrorResponse(jsonRPCMsg, -32601, "Method not found", nil)
+		}
+		logger.Debug("Routing to logging/setLevel handler")
+		return h.handleLoggingSetLevel(ctx, jsonRPCMsg)
+	case "completion/complete":
+		if h.completer == nil {
+			return h.createErrorResponse(jsonRPCMsg, -32601, "Method not found", nil)
+		}
+		logger.Debug("Routing to completion/complete handler")
+		return h.handleCompletionComplete(ctx, jsonRPCMsg)
 	default:
 		logger.WithFields(logrus.Fields{
 			"method": method,
@@ -231,13 +515,63 @@ func (h *MCPMessageHandler) handleMCPMessage(ctx context.Context, jsonRPCMsg map
 	}
 }
 
+// handleNotification 处理客户端通知
+func (h *MCPMessageHandler) handleNotification(ctx context.Context, method string, jsonRPCMsg map[string]interface{}) {
+	switch method {
+	case "notifications/initialized":
+		logger.Debug("Client initialization completed")
+	case "notifications/cancelled":
+		h.handleCancelled(ctx, jsonRPCMsg)
+	default:
+		logger.WithFields(logrus.Fields{
+			"method": method,
+		}).Debug("Ignoring unsupported notification")
+	}
+}
+
+// handleCancelled 处理客户端取消请求的通知
+func (h *MCPMessageHandler) handleCancelled(ctx context.Context, jsonRPCMsg map[string]interface{}) {
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	requestID, exists := params["requestId"]
+	if !exists {
+		logger.Warn("Received notifications/cancelled without requestId")
+		return
+	}
+
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return
+	}
+
+	cancelled := session.CancelRequest(requestID)
+	logger.WithFields(logrus.Fields{
+		"session_id": session.ID,
+		"request_id": requestID,
+		"reason":     params["reason"],
+		"found":      cancelled,
+	}).Info("Received cancellation for request")
+}
+
 // handleToolsList 处理工具列表请求
-func (h *MCPMessageHandler) handleToolsList(jsonRPCMsg map[string]interface{}) ([]byte, error) {
+func (h *MCPMessageHandler) handleToolsList(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
 	logger.WithFields(logrus.Fields{
 		"method": "tools/list",
 		"id":     jsonRPCMsg["id"],
 	}).Debug("Processing tools/list request")
 
+	// 支持按游标分页，以及按分类过滤（扩展参数 category）
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	category, _ := params["category"].(string)
+	offset := 0
+	if cursor, _ := params["cursor"].(string); cursor != "" {
+		var err error
+		if offset, err = decodeToolsCursor(cursor); err != nil {
+			return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+				"details": err.Error(),
+			})
+		}
+	}
+
 	// 动态获取工具列表
 	var tools []map[string]interface{}
 	
@@ -251,9 +585,21 @@ func (h *MCPMessageHandler) handleToolsList(jsonRPCMsg map[string]interface{}) (
 			"registered_tools":       registeredTools,
 		}).Debug("Retrieved registered tools from MCPServer")
 		
+		// outputSchema 仅对 2025-06-18 及以上版本的客户端返回
+		structuredOutput := false
+		if session := SessionFromContext(ctx); session != nil {
+			structuredOutput = session.SupportsStructuredOutput()
+		}
+
 		for _, toolName := range registeredTools {
-			toolInfo := h.getToolInfo(toolName)
-			if toolInfo != nil {
+			toolInfo, exists := h.mcpServer.GetToolInfo(toolName)
+			if exists {
+				if category != "" && !toolHasCategory(toolInfo, category) {
+					continue
+				}
+				if !structuredOutput {
+					delete(toolInfo, "outputSchema")
+				}
 				tools = append(tools, toolInfo)
 				logger.WithFields(logrus.Fields{
 					"tool_name":        toolName,
@@ -266,11 +612,25 @@ func (h *MCPMessageHandler) handleToolsList(jsonRPCMsg map[string]interface{}) (
 			}
 		}
 	} else {
-		// 如果没有MCPServer引用，使用默认的硬编码工具列表
-		logger.Warn("MCPServer reference is nil, using default tools")
-		tools = h.getDefaultTools()
+		// 如果没有MCPServer引用，返回空的工具列表
+		logger.Warn("MCPServer reference is nil, returning empty tools list")
+		tools = []map[string]interface{}{}
 	}
 
+	if offset > len(tools) {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "invalid cursor",
+		})
+	}
+	result := map[string]interface{}{}
+	end := len(tools)
+	if h.toolsPageSize > 0 && offset+h.toolsPageSize < end {
+		end = offset + h.toolsPageSize
+		result["nextCursor"] = encodeToolsCursor(end)
+	}
+	tools = tools[offset:end]
+	result["tools"] = tools
+
 	logger.WithFields(logrus.Fields{
 		"total_tools_returned": len(tools),
 		"tools_summary": func() []string {
@@ -285,9 +645,7 @@ func (h *MCPMessageHandler) handleToolsList(jsonRPCMsg map[string]interface{}) (
 	response := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"id":      jsonRPCMsg["id"],
-		"result": map[string]interface{}{
-			"tools": tools,
-		},
+		"result":  result,
 	}
 
 	responseBytes, err := json.Marshal(response)
@@ -313,1005 +671,6 @@ func (h *MCPMessageHandler) handleToolsList(jsonRPCMsg map[string]interface{}) (
 	return responseBytes, nil
 }
 
-// getToolInfo 获取特定工具的信息
-func (h *MCPMessageHandler) getToolInfo(toolName string) map[string]interface{} {
-	switch toolName {
-	case "ping":
-		return map[string]interface{}{
-			"name":        "ping",
-			"description": "简单的ping工具，用于测试MCP服务器连接和响应。返回指定的消息或默认的'pong'响应。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"message": map[string]interface{}{
-						"type":        "string",
-						"description": "要返回的消息",
-						"default":     "pong",
-					},
-				},
-			},
-		}
-	case "echo":
-		return map[string]interface{}{
-			"name":        "echo",
-			"description": "高级文本处理和格式化工具，支持大小写转换、前缀后缀添加、文本重复等功能。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"text": map[string]interface{}{
-						"type":        "string",
-						"description": "要处理的文本",
-					},
-					"uppercase": map[string]interface{}{
-						"type":        "boolean",
-						"description": "是否转换为大写",
-						"default":     false,
-					},
-					"lowercase": map[string]interface{}{
-						"type":        "boolean",
-						"description": "是否转换为小写",
-						"default":     false,
-					},
-					"prefix": map[string]interface{}{
-						"type":        "string",
-						"description": "添加前缀",
-					},
-					"suffix": map[string]interface{}{
-						"type":        "string",
-						"description": "添加后缀",
-					},
-					"repeat": map[string]interface{}{
-						"type":        "integer",
-						"description": "重复次数",
-						"minimum":     1,
-						"maximum":     10,
-						"default":     1,
-					},
-				},
-				"required": []string{"text"},
-			},
-		}
-	case "system_info":
-		return map[string]interface{}{
-			"name":        "system_info",
-			"description": "获取系统运行时信息，包括Go运行时、内存使用、环境变量、进程信息等。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"include_env": map[string]interface{}{
-						"type":        "boolean",
-						"description": "是否包含环境变量",
-						"default":     false,
-					},
-					"include_memory": map[string]interface{}{
-						"type":        "boolean",
-						"description": "是否包含内存信息",
-						"default":     true,
-					},
-				},
-			},
-		}
-	case "describe_regions":
-		return map[string]interface{}{
-			"name":        "describe_regions",
-			"description": "查询腾讯云产品支持的地域信息。支持多种产品(如tke、cvm、cos等)，支持 JSON 和表格两种输出格式。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"product": map[string]interface{}{
-						"type":        "string",
-						"description": "产品名称，如 tke、cvm、cos 等",
-						"enum":        []string{"tke", "cvm", "cos", "clb", "vpc", "cdb"},
-						"default":     "cvm",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-			},
-		}
-	case "get_region":
-		return map[string]interface{}{
-			"name":        "get_region",
-			"description": "根据地域ID查询腾讯云产品特定地域的详细信息。支持多种产品(如tke、cvm、cos等)，支持 JSON 和表格两种输出格式。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region_id": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing",
-					},
-					"product": map[string]interface{}{
-						"type":        "string",
-						"description": "产品名称，如 tke、cvm、cos 等",
-						"enum":        []string{"tke", "cvm", "cos", "clb", "vpc", "cdb"},
-						"default":     "cvm",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region_id"},
-			},
-		}
-	case "tencentcloud_validate":
-		return map[string]interface{}{
-			"name":        "tencentcloud_validate",
-			"description": "验证腾讯云 API 连接和权限配置。检查 SecretID、SecretKey 是否正确以及相关服务权限。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"service": map[string]interface{}{
-						"type":        "string",
-						"description": "要验证的服务名称，如 tke",
-						"default":     "tke",
-					},
-				},
-			},
-		}
-	case "tke_describe_clusters":
-		return map[string]interface{}{
-			"name":        "tke_describe_clusters",
-			"description": "查询指定地域的 TKE 集群列表。支持按集群类型过滤：all(全部)、tke(普通集群)、serverless(弹性集群)。默认查询全部集群。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_type": map[string]interface{}{
-						"type":        "string",
-						"description": "集群类型：all(全部集群)、tke(普通集群)、serverless(弹性集群)",
-						"enum":        []string{"all", "tke", "serverless"},
-						"default":     "all",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "tke_describe_cluster_extra_args":
-		return map[string]interface{}{
-			"name":        "tke_describe_cluster_extra_args",
-			"description": "查询指定地域下指定 TKE 集群的自定义参数(Etcd、KubeAPIServer、KubeControllerManager、KubeScheduler)。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "tke_get_cluster_level_price":
-		return map[string]interface{}{
-			"name":        "tke_get_cluster_level_price",
-			"description": "获取指定地域下指定集群等级的价格信息。集群等级可选：L20、L50、L100、L200、L500、L1000、L3000、L5000。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_level": map[string]interface{}{
-						"type":        "string",
-						"description": "集群等级",
-						"enum":        []string{"L20", "L50", "L100", "L200", "L500", "L1000", "L3000", "L5000"},
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_level"},
-			},
-		}
-	case "tke_describe_addon":
-		return map[string]interface{}{
-			"name":        "tke_describe_addon",
-			"description": "查询指定地域下指定 TKE 集群已安装的 addon 列表。可选指定 addon 名称查询特定 addon。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"addon_name": map[string]interface{}{
-						"type":        "string",
-						"description": "addon 名称，不传时返回集群下全部 addon",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "tke_get_app_chart_list":
-		return map[string]interface{}{
-			"name":        "tke_get_app_chart_list",
-			"description": "获取指定地域可安装的 TKE addon 列表。支持按类型(kind)、架构(arch)、集群类型(cluster_type)过滤。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"kind": map[string]interface{}{
-						"type":        "string",
-						"description": "app 类型",
-						"enum":        []string{"log", "scheduler", "network", "storage", "monitor", "dns", "image", "other", "invisible"},
-					},
-					"arch": map[string]interface{}{
-						"type":        "string",
-						"description": "支持的操作系统架构",
-						"enum":        []string{"arm32", "arm64", "amd64"},
-					},
-					"cluster_type": map[string]interface{}{
-						"type":        "string",
-						"description": "集群类型",
-						"enum":        []string{"tke", "eks"},
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "tke_describe_images":
-		return map[string]interface{}{
-			"name":        "tke_describe_images",
-			"description": "获取指定地域支持的 TKE 节点 OS 镜像列表。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "tke_describe_versions":
-		return map[string]interface{}{
-			"name":        "tke_describe_versions",
-			"description": "获取指定地域支持的 TKE 集群 Kubernetes 版本列表。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "tke_describe_log_switches":
-		return map[string]interface{}{
-			"name":        "tke_describe_log_switches",
-			"description": "查询指定地域下指定 TKE 集群的日志采集开关状态，包括审计日志、事件日志、普通日志和 Master 日志。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "tke_describe_master_component":
-		return map[string]interface{}{
-			"name":        "tke_describe_master_component",
-			"description": "查询指定地域下指定 TKE 集群的 master 组件运行状态。支持 kube-apiserver、kube-scheduler、kube-controller-manager，默认查询 kube-apiserver。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"component": map[string]interface{}{
-						"type":        "string",
-						"description": "master 组件名称",
-						"enum":        []string{"kube-apiserver", "kube-scheduler", "kube-controller-manager"},
-						"default":     "kube-apiserver",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "tke_describe_cluster_instances":
-		return map[string]interface{}{
-			"name":        "tke_describe_cluster_instances",
-			"description": "查询指定地域下指定 TKE 集群的节点实例列表，包含节点IP、角色、状态、封锁状态、节点池等信息。支持按节点角色过滤。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"instance_role": map[string]interface{}{
-						"type":        "string",
-						"description": "节点角色",
-						"enum":        []string{"WORKER", "MASTER", "ETCD", "MASTER_ETCD", "ALL"},
-						"default":     "WORKER",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "tke_describe_cluster_virtual_node":
-		return map[string]interface{}{
-			"name":        "tke_describe_cluster_virtual_node",
-			"description": "查询指定地域下指定 TKE 集群的超级节点列表。可选指定节点池ID过滤。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"cluster_id": map[string]interface{}{
-						"type":        "string",
-						"description": "集群ID",
-					},
-					"node_pool_id": map[string]interface{}{
-						"type":        "string",
-						"description": "节点池ID，不传时返回集群下全部超级节点",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "cluster_id"},
-			},
-		}
-	case "cvm_describe_instances":
-		return map[string]interface{}{
-			"name":        "cvm_describe_instances",
-			"description": "查询指定地域的 CVM 实例列表。支持按实例ID、实例名称、可用区、项目ID等过滤。返回实例的基本信息、网络配置、磁盘信息等。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "实例ID列表，多个用逗号分隔，如 ins-xxx1,ins-xxx2",
-					},
-					"instance_name": map[string]interface{}{
-						"type":        "string",
-						"description": "实例名称，支持模糊匹配",
-					},
-					"zone": map[string]interface{}{
-						"type":        "string",
-						"description": "可用区，如 ap-beijing-1",
-					},
-					"project_id": map[string]interface{}{
-						"type":        "string",
-						"description": "项目ID",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大100",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "cvm_describe_instances_status":
-		return map[string]interface{}{
-			"name":        "cvm_describe_instances_status",
-			"description": "查询指定地域的 CVM 实例状态列表。返回实例ID和对应的运行状态(RUNNING/STOPPED/PENDING等)。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "实例ID列表，多个用逗号分隔，如 ins-xxx1,ins-xxx2",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大100",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "clb_describe_load_balancers":
-		return map[string]interface{}{
-			"name":        "clb_describe_load_balancers",
-			"description": "查询指定地域的 CLB 负载均衡实例列表。支持按实例ID、名称、类型(OPEN/INTERNAL)、VIP等过滤。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"load_balancer_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例ID列表，多个用逗号分隔",
-					},
-					"load_balancer_name": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例名称",
-					},
-					"load_balancer_type": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡类型：OPEN(公网)、INTERNAL(内网)",
-						"enum":        []string{"OPEN", "INTERNAL"},
-					},
-					"load_balancer_vip": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例VIP",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大100",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "clb_describe_listeners":
-		return map[string]interface{}{
-			"name":        "clb_describe_listeners",
-			"description": "查询指定地域下指定 CLB 实例的监听器列表。返回监听器的协议、端口、健康检查配置等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"load_balancer_id": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例ID",
-					},
-					"listener_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "监听器ID列表，多个用逗号分隔",
-					},
-					"protocol": map[string]interface{}{
-						"type":        "string",
-						"description": "监听器协议类型",
-						"enum":        []string{"TCP", "UDP", "HTTP", "HTTPS", "TCP_SSL", "QUIC"},
-					},
-					"port": map[string]interface{}{
-						"type":        "string",
-						"description": "监听器端口",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "load_balancer_id"},
-			},
-		}
-	case "clb_describe_targets":
-		return map[string]interface{}{
-			"name":        "clb_describe_targets",
-			"description": "查询指定地域下指定 CLB 实例绑定的后端目标(RS)列表。可选指定监听器ID过滤。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"load_balancer_id": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例ID",
-					},
-					"listener_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "监听器ID列表，多个用逗号分隔",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "load_balancer_id"},
-			},
-		}
-	case "clb_describe_target_health":
-		return map[string]interface{}{
-			"name":        "clb_describe_target_health",
-			"description": "查询指定地域下指定 CLB 实例后端目标的健康检查状态。支持查询多个 CLB 实例(逗号分隔)。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"load_balancer_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "负载均衡实例ID列表，多个用逗号分隔",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "load_balancer_ids"},
-			},
-		}
-	case "cdb_describe_db_instances":
-		return map[string]interface{}{
-			"name":        "cdb_describe_db_instances",
-			"description": "查询指定地域的 CDB (MySQL) 实例列表。支持按实例ID、实例名称、状态等过滤。返回实例基本信息、配置、网络等。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_ids": map[string]interface{}{
-						"type":        "string",
-						"description": "实例ID列表，多个用逗号分隔",
-					},
-					"instance_name": map[string]interface{}{
-						"type":        "string",
-						"description": "实例名称，支持模糊匹配",
-					},
-					"status": map[string]interface{}{
-						"type":        "string",
-						"description": "实例状态：0-创建中 1-运行中 4-隔离中 5-已隔离",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大2000",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "cdb_describe_db_instance_info":
-		return map[string]interface{}{
-			"name":        "cdb_describe_db_instance_info",
-			"description": "查询指定地域下指定 CDB (MySQL) 实例的详细信息，包括实例配置、网络信息、参数等。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_id": map[string]interface{}{
-						"type":        "string",
-						"description": "CDB 实例ID",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "instance_id"},
-			},
-		}
-	case "cdb_describe_slow_logs":
-		return map[string]interface{}{
-			"name":        "cdb_describe_slow_logs",
-			"description": "查询指定地域下指定 CDB (MySQL) 实例的慢查询日志文件列表。返回慢日志文件名、大小、时间等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_id": map[string]interface{}{
-						"type":        "string",
-						"description": "CDB 实例ID",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大100",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "instance_id"},
-			},
-		}
-	case "cdb_describe_error_log":
-		return map[string]interface{}{
-			"name":        "cdb_describe_error_log",
-			"description": "查询指定地域下指定 CDB (MySQL) 实例的错误日志数据。支持按时间范围和关键字过滤。默认查询最近1小时。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"instance_id": map[string]interface{}{
-						"type":        "string",
-						"description": "CDB 实例ID",
-					},
-					"start_time": map[string]interface{}{
-						"type":        "string",
-						"description": "开始时间，格式：2006-01-02 15:04:05 或 Unix时间戳",
-					},
-					"end_time": map[string]interface{}{
-						"type":        "string",
-						"description": "结束时间，格式：2006-01-02 15:04:05 或 Unix时间戳",
-					},
-					"key_word": map[string]interface{}{
-						"type":        "string",
-						"description": "搜索关键字",
-					},
-					"limit": map[string]interface{}{
-						"type":        "string",
-						"description": "返回数量，默认20，最大400",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region", "instance_id"},
-			},
-		}
-	// ========== VPC 工具 Schema ==========
-	case "vpc_describe_vpcs":
-		return map[string]interface{}{
-			"name":        "vpc_describe_vpcs",
-			"description": "查询指定地域的 VPC 列表。返回 VPC ID、名称、CIDR、是否默认、DHCP、DNS 等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_subnets":
-		return map[string]interface{}{
-			"name":        "vpc_describe_subnets",
-			"description": "查询指定地域的子网列表。支持按 VPC ID 过滤。返回子网ID、CIDR、可用区、可用IP数等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"vpc_id": map[string]interface{}{
-						"type":        "string",
-						"description": "VPC 实例ID，不传则查询所有子网",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_security_groups":
-		return map[string]interface{}{
-			"name":        "vpc_describe_security_groups",
-			"description": "查询指定地域的安全组列表。返回安全组ID、名称、描述、是否默认等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_network_interfaces":
-		return map[string]interface{}{
-			"name":        "vpc_describe_network_interfaces",
-			"description": "查询指定地域的弹性网卡(ENI)列表。支持按 VPC ID 过滤。返回网卡ID、MAC、状态、内网IP等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"vpc_id": map[string]interface{}{
-						"type":        "string",
-						"description": "VPC 实例ID，不传则查询所有网卡",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_addresses":
-		return map[string]interface{}{
-			"name":        "vpc_describe_addresses",
-			"description": "查询指定地域的弹性公网IP(EIP)列表。返回 EIP ID、公网IP、状态、绑定实例、带宽等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_bandwidth_packages":
-		return map[string]interface{}{
-			"name":        "vpc_describe_bandwidth_packages",
-			"description": "查询指定地域的带宽包列表。返回带宽包ID、名称、网络类型、计费类型、带宽、状态等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_vpc_endpoint":
-		return map[string]interface{}{
-			"name":        "vpc_describe_vpc_endpoint",
-			"description": "查询指定地域的终端节点列表。返回终端节点ID、名称、VPC、VIP、服务ID、状态等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_vpc_endpoint_service":
-		return map[string]interface{}{
-			"name":        "vpc_describe_vpc_endpoint_service",
-			"description": "查询指定地域的终端节点服务列表。返回服务ID、名称、VPC、VIP、服务类型、终端节点数等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	case "vpc_describe_vpc_peering_connections":
-		return map[string]interface{}{
-			"name":        "vpc_describe_vpc_peering_connections",
-			"description": "查询指定地域的对等连接列表。返回对等连接ID、名称、本端/对端VPC、地域、状态、带宽等信息。",
-			"inputSchema": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"region": map[string]interface{}{
-						"type":        "string",
-						"description": "地域ID，如 ap-beijing、ap-shanghai 等",
-					},
-					"format": map[string]interface{}{
-						"type":        "string",
-						"description": "输出格式：json 或 table",
-						"enum":        []string{"json", "table"},
-						"default":     "table",
-					},
-				},
-				"required": []string{"region"},
-			},
-		}
-	default:
-		// 对于未知工具，返回基本信息
-		return map[string]interface{}{
-			"name":        toolName,
-			"description": fmt.Sprintf("工具: %s", toolName),
-			"inputSchema": map[string]interface{}{
-				"type":       "object",
-				"properties": map[string]interface{}{},
-			},
-		}
-	}
-}
-
-// getDefaultTools 获取默认工具列表（作为后备）
-func (h *MCPMessageHandler) getDefaultTools() []map[string]interface{} {
-	return []map[string]interface{}{
-		h.getToolInfo("ping"),
-		h.getToolInfo("echo"),
-		h.getToolInfo("system_info"),
-	}
-}
-
 // handleToolsCall 处理工具调用请求
 func (h *MCPMessageHandler) handleToolsCall(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
 	logger.WithFields(logrus.Fields{
@@ -1344,41 +703,116 @@ func (h *MCPMessageHandler) handleToolsCall(ctx context.Context, jsonRPCMsg map[
 		"arguments":  arguments,
 	}).Debug("Extracted tool call parameters")
 
+	// _meta.async 为 true 时提交异步任务，立即返回任务ID，不占用工具调用并发名额
+	if async, callbackURL := asyncOptionsFromParams(params); async {
+		return h.submitAsyncToolCall(ctx, jsonRPCMsg, toolName, arguments, callbackURL)
+	}
+
+	// 并发已满时排队等待，超出队列或等待超时返回服务繁忙
+	if h.limiter != nil {
+		key := limiterKey(ctx)
+		release, err := h.limiter.Acquire(ctx, key)
+		var busyErr *BusyError
+		if errors.As(err, &busyErr) {
+			logger.WithFields(logrus.Fields{
+				"tool_name": toolName,
+				"client":    key,
+				"details":   busyErr.Details(),
+			}).Warn("Rejected tool call, server busy")
+			return h.createErrorResponse(jsonRPCMsg, -32000, "Server busy", busyErr.Details())
+		}
+		if err != nil {
+			// 排队期间请求被取消或超时
+			return h.createErrorResponse(jsonRPCMsg, -32001, "Request timed out", map[string]interface{}{
+				"details": err.Error(),
+			})
+		}
+
+		// batch_call 的每个子调用单独获取名额，首个子调用沿用本次调用已占用的名额
+		ctx = caller.WithAcquirer(ctx, h.limiter.SubcallAcquirer(key, release))
+		// 工具超时后处理函数仍在运行时，名额保留到其返回
+		ctx, release = caller.WithSlot(ctx, release)
+		defer release()
+	}
+
+	// 请求携带 progressToken 时，工具上报的进度以 notifications/progress 推送给客户端
+	if token, ok := progressTokenFromParams(params); ok {
+		if session := SessionFromContext(ctx); session != nil {
+			notifier := newProgressNotifier(session, token)
+			defer notifier.stop()
+			ctx = progress.WithReporter(ctx, notifier.report)
+		}
+	}
+
+	// 工具调用期间绑定上下文记录的日志以 notifications/message 转发给客户端
+	if h.loggingEnabled {
+		if session := SessionFromContext(ctx); session != nil {
+			forwarder := newSessionLogForwarder(session, toolName, jsonRPCMsg["id"])
+			defer forwarder.stop()
+			ctx = logger.WithSessionLog(ctx, forwarder.forward)
+		}
+	}
+
 	// 调用具体的工具
 	result, err := h.callTool(ctx, toolName, arguments)
+	var timeoutErr TimeoutError
+	if errors.As(err, &timeoutErr) && timeoutErr.IsTimeout() {
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+			"details":   timeoutErr.Details(),
+		}).Warn("Tool call timed out")
+
+		// 工具执行超时属于工具执行失败，以 isError 结果返回；-32001 只用于排队期间请求被取消或超时
+		details := map[string]interface{}{
+			"category": "timeout",
+		}
+		for key, value := range timeoutErr.Details() {
+			details[key] = value
+		}
+		return h.createToolErrorResult(jsonRPCMsg, timeoutErr.Error(), details)
+	}
+	if errors.Is(err, errUnknownTool) {
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+		}).Warn("Unknown tool requested")
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Unknown tool", map[string]interface{}{
+			"tool": toolName,
+		})
+	}
+	var toolErr ToolExecutionError
+	if errors.As(err, &toolErr) {
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+			"category":  toolErr.ErrorCategory(),
+			"error":     toolErr.Error(),
+		}).Warn("Tool execution failed")
+		return h.createToolErrorResult(jsonRPCMsg, toolErr.Error(), toolErr.Details())
+	}
 	if err != nil {
 		logger.WithFields(logrus.Fields{
 			"tool_name": toolName,
 			"arguments": arguments,
 			"error":     err.Error(),
-		}).Error("Tool execution failed")
-		return h.createErrorResponse(jsonRPCMsg, -32603, "Tool execution failed", map[string]interface{}{
+		}).Error("Tool call failed")
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
 			"details": err.Error(),
 		})
 	}
 
+	// structuredContent 仅对 2025-06-18 及以上版本的客户端返回
+	if session := SessionFromContext(ctx); session == nil || !session.SupportsStructuredOutput() {
+		delete(result, "structuredContent")
+	}
+
 	logger.WithFields(logrus.Fields{
-		"tool_name":     toolName,
-		"result_length": len(result),
-		"result_preview": func() string {
-			if len(result) > 200 {
-				return result[:200] + "..."
-			}
-			return result
-		}(),
+		"tool_name":          toolName,
+		"structured_content": result["structuredContent"] != nil,
 	}).Debug("Tool execution completed successfully")
 
 	response := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"id":      jsonRPCMsg["id"],
-		"result": map[string]interface{}{
-			"content": []map[string]interface{}{
-				{
-					"type": "text",
-					"text": result,
-				},
-			},
-		},
+		"result":  result,
 	}
 
 	responseBytes, err := json.Marshal(response)
@@ -1404,58 +838,62 @@ func (h *MCPMessageHandler) handleToolsCall(ctx context.Context, jsonRPCMsg map[
 	return responseBytes, nil
 }
 
-// handleResourcesList 处理资源列表请求
-func (h *MCPMessageHandler) handleResourcesList(jsonRPCMsg map[string]interface{}) ([]byte, error) {
-	response := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      jsonRPCMsg["id"],
-		"result": map[string]interface{}{
-			"resources": []interface{}{},
-		},
+// limiterKey 并发限制的键
+// 优先按调用方标识（客户端IP）限制，客户端新建会话不能绕过单客户端并发上限；没有调用方标识时（如 stdio）按会话限制
+func limiterKey(ctx context.Context) string {
+	if identity := caller.FromContext(ctx); identity != "" {
+		return identity
 	}
+	if session := SessionFromContext(ctx); session != nil {
+		return session.ID
+	}
+	return ""
+}
 
-	responseBytes, err := json.Marshal(response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal resources list response: %w", err)
+// callTool 调用具体的工具（统一通过全局注册表调用）
+func (h *MCPMessageHandler) callTool(ctx context.Context, toolName string, arguments map[string]interface{}) (map[string]interface{}, error) {
+	// 统一通过工具注册表调用所有工具
+	if h.toolRegistry != nil {
+		result, err := h.toolRegistry.CallTool(ctx, toolName, arguments)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			return result, nil
+		}
 	}
 
-	return responseBytes, nil
+	return nil, fmt.Errorf("%w: %s (工具未在全局注册表中注册)", errUnknownTool, toolName)
 }
 
-// handlePromptsList 处理提示列表请求
-func (h *MCPMessageHandler) handlePromptsList(jsonRPCMsg map[string]interface{}) ([]byte, error) {
+// createToolErrorResult 创建工具执行失败的结果
+// 失败信息放在 isError 结果的文本内容中，错误分类等结构化信息放在 _meta.error
+func (h *MCPMessageHandler) createToolErrorResult(jsonRPCMsg map[string]interface{}, message string, details map[string]interface{}) ([]byte, error) {
 	response := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"id":      jsonRPCMsg["id"],
 		"result": map[string]interface{}{
-			"prompts": []interface{}{},
+			"content": []map[string]interface{}{
+				{
+					"type": "text",
+					"text": message,
+				},
+			},
+			"isError": true,
+			"_meta": map[string]interface{}{
+				"error": details,
+			},
 		},
 	}
 
 	responseBytes, err := json.Marshal(response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal prompts list response: %w", err)
+		return nil, fmt.Errorf("failed to marshal tool error result: %w", err)
 	}
 
 	return responseBytes, nil
 }
 
-// callTool 调用具体的工具（统一通过全局注册表调用）
-func (h *MCPMessageHandler) callTool(ctx context.Context, toolName string, arguments map[string]interface{}) (string, error) {
-	// 统一通过工具注册表调用所有工具
-	if h.toolRegistry != nil {
-		result, err := h.toolRegistry.CallTool(ctx, toolName, arguments)
-		if err != nil {
-			return "", err
-		}
-		if result != "" {
-			return result, nil
-		}
-	}
-
-	return "", fmt.Errorf("unknown tool: %s (工具未在全局注册表中注册)", toolName)
-}
-
 // HandleRequest 处理HTTP请求 (实现MCPHandler接口)
 func (h *MCPMessageHandler) HandleRequest(request map[string]interface{}) map[string]interface{} {
 	ctx := context.Background()
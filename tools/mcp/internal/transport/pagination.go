@@ -0,0 +1,46 @@
+package transport
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// toolsCursorPrefix tools/list 游标的前缀，游标内容为下一页起始位置
+const toolsCursorPrefix = "tools:"
+
+// SetToolsPageSize 设置 tools/list 每页返回的工具数量，0 表示不分页
+func (h *MCPMessageHandler) SetToolsPageSize(size int) {
+	h.toolsPageSize = size
+}
+
+// encodeToolsCursor 生成 tools/list 的分页游标
+func encodeToolsCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(toolsCursorPrefix + strconv.Itoa(offset)))
+}
+
+// decodeToolsCursor 解析 tools/list 的分页游标，返回下一页起始位置
+func decodeToolsCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(data), toolsCursorPrefix) {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(data), toolsCursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	return offset, nil
+}
+
+// toolHasCategory 判断工具描述的 _meta.categories 中是否包含指定分类
+func toolHasCategory(toolInfo map[string]interface{}, category string) bool {
+	meta, _ := toolInfo["_meta"].(map[string]interface{})
+	categories, _ := meta["categories"].([]string)
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
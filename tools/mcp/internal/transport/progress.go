@@ -0,0 +1,90 @@
+package transport
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// protocolVersionProgressMessage 进度通知支持 message 字段的最低协议版本
+const protocolVersionProgressMessage = "2025-03-26"
+
+// progressNotifier 将一次工具调用的进度以 notifications/progress 推送给客户端
+// HTTP 模式经由会话的 SSE 流推送，stdio 模式写到标准输出
+type progressNotifier struct {
+	session        *Session
+	token          interface{}
+	includeMessage bool
+	last           float64
+	reported       bool
+	stopped        bool
+	mutex          sync.Mutex
+}
+
+// newProgressNotifier 创建绑定到 progressToken 的进度通知器
+func newProgressNotifier(session *Session, token interface{}) *progressNotifier {
+	return &progressNotifier{
+		session:        session,
+		token:          token,
+		includeMessage: protocolVersionAtLeast(session.GetProtocolVersion(), protocolVersionProgressMessage),
+	}
+}
+
+// report 推送一条进度通知，total 未知时为 0
+// MCP 规范要求进度值单调递增，不大于上次进度的上报被忽略；请求结束后不再推送
+func (n *progressNotifier) report(progress, total float64, message string) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.stopped || (n.reported && progress <= n.last) {
+		return
+	}
+	n.last = progress
+	n.reported = true
+
+	params := map[string]interface{}{
+		"progressToken": n.token,
+		"progress":      progress,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" && n.includeMessage {
+		params["message"] = message
+	}
+
+	if err := n.session.Notify("notifications/progress", params); err != nil {
+		logger.WithFields(logrus.Fields{
+			"session_id":     n.session.ID,
+			"progress_token": n.token,
+			"error":          err.Error(),
+		}).Warn("Failed to send progress notification")
+	}
+}
+
+// stop 停止推送进度，请求响应发出前调用
+func (n *progressNotifier) stop() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.stopped = true
+}
+
+// progressTokenFromParams 从请求参数的 _meta.progressToken 中提取进度令牌
+// 令牌只能是字符串或整数，其他类型视为未提供
+func progressTokenFromParams(params map[string]interface{}) (interface{}, bool) {
+	meta, ok := params["_meta"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	switch token := meta["progressToken"].(type) {
+	case string:
+		return token, token != ""
+	case float64:
+		return token, token == float64(int64(token))
+	default:
+		return nil, false
+	}
+}
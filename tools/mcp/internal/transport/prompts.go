@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// PromptProvider 提示模板提供者接口，避免循环依赖
+type PromptProvider interface {
+	// ListPrompts 返回 prompts/list 中的提示模板描述
+	ListPrompts() []map[string]interface{}
+	// GetPrompt 返回 prompts/get 的结果，提示模板不存在时返回 nil，参数无效时返回错误
+	GetPrompt(name string, arguments map[string]string) (map[string]interface{}, error)
+}
+
+// SetPromptProvider 设置提示模板提供者
+func (h *MCPMessageHandler) SetPromptProvider(provider PromptProvider) {
+	h.prompts = provider
+}
+
+// handlePromptsList 处理提示列表请求
+func (h *MCPMessageHandler) handlePromptsList(jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	prompts := []map[string]interface{}{}
+	if h.prompts != nil {
+		prompts = h.prompts.ListPrompts()
+	}
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{
+		"prompts": prompts,
+	})
+}
+
+// handlePromptsGet 处理提示获取请求
+func (h *MCPMessageHandler) handlePromptsGet(jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	name, _ := params["name"].(string)
+	if name == "" {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "name is required",
+		})
+	}
+
+	arguments := map[string]string{}
+	if args, ok := params["arguments"].(map[string]interface{}); ok {
+		for key, value := range args {
+			if s, ok := value.(string); ok {
+				arguments[key] = s
+			}
+		}
+	}
+
+	var result map[string]interface{}
+	var err error
+	if h.prompts != nil {
+		result, err = h.prompts.GetPrompt(name, arguments)
+	}
+	if err != nil {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"prompt":  name,
+			"details": err.Error(),
+		})
+	}
+	if result == nil {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Unknown prompt", map[string]interface{}{
+			"prompt": name,
+		})
+	}
+
+	logger.WithFields(logrus.Fields{
+		"prompt":    name,
+		"arguments": len(arguments),
+	}).Debug("Rendered prompt")
+
+	return h.createResultResponse(jsonRPCMsg, result)
+}
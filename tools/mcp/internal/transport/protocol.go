@@ -0,0 +1,34 @@
+package transport
+
+// SupportedProtocolVersions 服务器支持的 MCP 协议版本，按从新到旧排列
+var SupportedProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// protocolVersionStructuredOutput 支持 outputSchema 和 structuredContent 的最低协议版本
+const protocolVersionStructuredOutput = "2025-06-18"
+
+// IsSupportedProtocolVersion 判断协议版本是否受支持
+func IsSupportedProtocolVersion(version string) bool {
+	for _, supported := range SupportedProtocolVersions {
+		if supported == version {
+			return true
+		}
+	}
+	return false
+}
+
+// NegotiateProtocolVersion 根据客户端请求的版本协商协议版本
+// 返回不高于请求版本的最高支持版本；请求的版本比服务器支持的都旧时返回服务器的最新版本，由客户端决定是否断开
+func NegotiateProtocolVersion(requested string) string {
+	// 协议版本为 YYYY-MM-DD 格式，可直接按字符串比较新旧
+	for _, supported := range SupportedProtocolVersions {
+		if supported <= requested {
+			return supported
+		}
+	}
+	return SupportedProtocolVersions[0]
+}
+
+// protocolVersionAtLeast 判断协商的协议版本是否不低于指定版本
+func protocolVersionAtLeast(version, minimum string) bool {
+	return version >= minimum
+}
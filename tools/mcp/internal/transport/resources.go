@@ -0,0 +1,374 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// ResourceProvider 资源提供者接口，避免循环依赖
+type ResourceProvider interface {
+	// ListResources 返回 resources/list 中的资源描述
+	ListResources(ctx context.Context) ([]map[string]interface{}, error)
+	// ListResourceTemplates 返回 resources/templates/list 中的模板描述
+	ListResourceTemplates() []map[string]interface{}
+	// ReadResource 返回 resources/read 结果中的 contents，资源不存在时返回 nil
+	ReadResource(ctx context.Context, uri string) ([]map[string]interface{}, error)
+	// HasResource 判断 URI 是否匹配可用的资源或资源模板
+	HasResource(uri string) bool
+	// SetUpdateHandler 设置被订阅资源内容变化时的回调
+	SetUpdateHandler(handler func(uri string))
+	// Watch 开始定期刷新资源
+	Watch(uri string)
+	// Unwatch 停止刷新资源
+	Unwatch(uri string)
+}
+
+// maxSubscriptionsPerSession 单个会话最多订阅的资源数，每个被订阅的资源都会定期调用云 API 刷新
+const maxSubscriptionsPerSession = 20
+
+// errTooManySubscriptions 会话订阅的资源数已达上限
+var errTooManySubscriptions = fmt.Errorf("subscription limit reached: at most %d resources per session", maxSubscriptionsPerSession)
+
+// resourceSubscriptions 资源订阅关系，按 URI 记录订阅的会话，同时按会话记录订阅的 URI
+type resourceSubscriptions struct {
+	subscribers map[string]map[*Session]struct{}
+	bySession   map[*Session]map[string]struct{}
+	mutex       sync.Mutex
+}
+
+// newResourceSubscriptions 创建资源订阅关系
+func newResourceSubscriptions() *resourceSubscriptions {
+	return &resourceSubscriptions{
+		subscribers: make(map[string]map[*Session]struct{}),
+		bySession:   make(map[*Session]map[string]struct{}),
+	}
+}
+
+// subscribe 记录会话对资源的订阅
+// 返回是否为该资源的第一个订阅者、是否为该会话的第一个订阅；会话订阅数达到上限时返回 errTooManySubscriptions
+func (s *resourceSubscriptions) subscribe(uri string, session *Session) (bool, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	uris, sessionExists := s.bySession[session]
+	if _, subscribed := uris[uri]; subscribed {
+		return false, false, nil
+	}
+	if len(uris) >= maxSubscriptionsPerSession {
+		return false, false, errTooManySubscriptions
+	}
+	if !sessionExists {
+		uris = make(map[string]struct{})
+		s.bySession[session] = uris
+	}
+	uris[uri] = struct{}{}
+
+	sessions, exists := s.subscribers[uri]
+	if !exists {
+		sessions = make(map[*Session]struct{})
+		s.subscribers[uri] = sessions
+	}
+	sessions[session] = struct{}{}
+	return !exists, !sessionExists, nil
+}
+
+// unsubscribe 取消会话对资源的订阅，返回资源是否已没有订阅者
+func (s *resourceSubscriptions) unsubscribe(uri string, session *Session) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.unsubscribeLocked(uri, session)
+}
+
+// unsubscribeLocked 取消会话对资源的订阅，调用方需持有锁
+func (s *resourceSubscriptions) unsubscribeLocked(uri string, session *Session) bool {
+	if uris, exists := s.bySession[session]; exists {
+		delete(uris, uri)
+		if len(uris) == 0 {
+			delete(s.bySession, session)
+		}
+	}
+
+	sessions, exists := s.subscribers[uri]
+	if !exists {
+		return false
+	}
+	delete(sessions, session)
+	if len(sessions) == 0 {
+		delete(s.subscribers, uri)
+		return true
+	}
+	return false
+}
+
+// removeSession 取消会话的全部订阅，返回已没有订阅者的资源
+func (s *resourceSubscriptions) removeSession(session *Session) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var empty []string
+	for uri := range s.bySession[session] {
+		if s.unsubscribeLocked(uri, session) {
+			empty = append(empty, uri)
+		}
+	}
+	return empty
+}
+
+// active 获取订阅资源的会话，同时移除已结束的会话；没有订阅者时第二个返回值为 true
+func (s *resourceSubscriptions) active(uri string) ([]*Session, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sessions := s.subscribers[uri]
+	result := make([]*Session, 0, len(sessions))
+	for session := range sessions {
+		if session.Context().Err() != nil {
+			delete(sessions, session)
+			if uris, exists := s.bySession[session]; exists {
+				delete(uris, uri)
+				if len(uris) == 0 {
+					delete(s.bySession, session)
+				}
+			}
+			continue
+		}
+		result = append(result, session)
+	}
+	if len(sessions) == 0 {
+		delete(s.subscribers, uri)
+		return nil, true
+	}
+	return result, false
+}
+
+// SetResourceProvider 设置资源提供者，设置后声明 resources 能力（支持订阅）
+func (h *MCPMessageHandler) SetResourceProvider(provider ResourceProvider) {
+	h.resources = provider
+	if provider != nil {
+		provider.SetUpdateHandler(h.notifyResourceUpdated)
+	}
+}
+
+// notifyResourceUpdated 向订阅资源的会话推送 notifications/resources/updated
+func (h *MCPMessageHandler) notifyResourceUpdated(uri string) {
+	sessions, empty := h.subscriptions.active(uri)
+	if empty {
+		h.resources.Unwatch(uri)
+		return
+	}
+
+	for _, session := range sessions {
+		if err := session.Notify("notifications/resources/updated", map[string]interface{}{
+			"uri": uri,
+		}); err != nil {
+			logger.WithFields(logrus.Fields{
+				"session_id": session.ID,
+				"uri":        uri,
+				"error":      err.Error(),
+			}).Warn("Failed to send resource update notification")
+		}
+	}
+}
+
+// handleResourcesList 处理资源列表请求
+func (h *MCPMessageHandler) handleResourcesList(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	resources := []map[string]interface{}{}
+	if h.resources != nil {
+		list, err := h.resources.ListResources(ctx)
+		if err != nil {
+			return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+				"details": err.Error(),
+			})
+		}
+		resources = list
+	}
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{
+		"resources": resources,
+	})
+}
+
+// handleResourceTemplatesList 处理资源模板列表请求
+func (h *MCPMessageHandler) handleResourceTemplatesList(jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	templates := []map[string]interface{}{}
+	if h.resources != nil {
+		templates = h.resources.ListResourceTemplates()
+	}
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{
+		"resourceTemplates": templates,
+	})
+}
+
+// handleResourcesRead 处理资源读取请求
+// 读取资源会调用云 API，与工具调用共用按客户端计算的并发名额
+func (h *MCPMessageHandler) handleResourcesRead(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	uri, ok := resourceURIFromParams(jsonRPCMsg)
+	if !ok {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "uri is required",
+		})
+	}
+
+	if h.limiter != nil {
+		key := limiterKey(ctx)
+		release, err := h.limiter.Acquire(ctx, key)
+		var busyErr *BusyError
+		if errors.As(err, &busyErr) {
+			logger.WithFields(logrus.Fields{
+				"uri":     uri,
+				"client":  key,
+				"details": busyErr.Details(),
+			}).Warn("Rejected resource read, server busy")
+			return h.createErrorResponse(jsonRPCMsg, -32000, "Server busy", busyErr.Details())
+		}
+		if err != nil {
+			// 排队期间请求被取消或超时
+			return h.createErrorResponse(jsonRPCMsg, -32001, "Request timed out", map[string]interface{}{
+				"details": err.Error(),
+			})
+		}
+		defer release()
+	}
+
+	var contents []map[string]interface{}
+	var err error
+	if h.resources != nil {
+		contents, err = h.resources.ReadResource(ctx, uri)
+	}
+
+	var toolErr ToolExecutionError
+	if errors.As(err, &toolErr) {
+		logger.WithFields(logrus.Fields{
+			"uri":      uri,
+			"category": toolErr.ErrorCategory(),
+			"error":    toolErr.Error(),
+		}).Warn("Resource read failed")
+		data := toolErr.Details()
+		data["uri"] = uri
+		data["details"] = toolErr.Error()
+		if toolErr.ErrorCategory() == "not_found" {
+			return h.createErrorResponse(jsonRPCMsg, -32002, "Resource not found", data)
+		}
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", data)
+	}
+	if err != nil {
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+			"uri":     uri,
+			"details": err.Error(),
+		})
+	}
+	if contents == nil {
+		return h.createErrorResponse(jsonRPCMsg, -32002, "Resource not found", map[string]interface{}{
+			"uri": uri,
+		})
+	}
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{
+		"contents": contents,
+	})
+}
+
+// handleResourcesSubscribe 处理资源订阅请求，资源内容变化时向当前会话推送通知
+// 只能订阅可用的资源，单个会话的订阅数有上限；会话结束（删除或空闲过期）时其订阅全部取消
+func (h *MCPMessageHandler) handleResourcesSubscribe(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	uri, ok := resourceURIFromParams(jsonRPCMsg)
+	if !ok {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "uri is required",
+		})
+	}
+
+	session := SessionFromContext(ctx)
+	if session == nil {
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+			"details": "no session for request",
+		})
+	}
+
+	if h.resources == nil || !h.resources.HasResource(uri) {
+		return h.createErrorResponse(jsonRPCMsg, -32002, "Resource not found", map[string]interface{}{
+			"uri": uri,
+		})
+	}
+
+	first, firstForSession, err := h.subscriptions.subscribe(uri, session)
+	if err != nil {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"uri":     uri,
+			"details": err.Error(),
+		})
+	}
+	if first {
+		h.resources.Watch(uri)
+	}
+	if firstForSession {
+		go h.releaseSubscriptions(session)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"session_id": session.ID,
+		"uri":        uri,
+	}).Info("Session subscribed to resource")
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{})
+}
+
+// releaseSubscriptions 会话结束后取消其全部订阅，并停止刷新已没有订阅者的资源
+func (h *MCPMessageHandler) releaseSubscriptions(session *Session) {
+	<-session.Context().Done()
+	for _, uri := range h.subscriptions.removeSession(session) {
+		h.resources.Unwatch(uri)
+	}
+}
+
+// handleResourcesUnsubscribe 处理取消资源订阅请求
+func (h *MCPMessageHandler) handleResourcesUnsubscribe(ctx context.Context, jsonRPCMsg map[string]interface{}) ([]byte, error) {
+	uri, ok := resourceURIFromParams(jsonRPCMsg)
+	if !ok {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+			"details": "uri is required",
+		})
+	}
+
+	if session := SessionFromContext(ctx); session != nil {
+		if h.subscriptions.unsubscribe(uri, session) {
+			h.resources.Unwatch(uri)
+		}
+		logger.WithFields(logrus.Fields{
+			"session_id": session.ID,
+			"uri":        uri,
+		}).Info("Session unsubscribed from resource")
+	}
+
+	return h.createResultResponse(jsonRPCMsg, map[string]interface{}{})
+}
+
+// resourceURIFromParams 从请求参数中获取资源 URI
+func resourceURIFromParams(jsonRPCMsg map[string]interface{}) (string, bool) {
+	params, _ := jsonRPCMsg["params"].(map[string]interface{})
+	uri, _ := params["uri"].(string)
+	return uri, uri != ""
+}
+
+// createResultResponse 创建成功响应
+func (h *MCPMessageHandler) createResultResponse(jsonRPCMsg map[string]interface{}, result map[string]interface{}) ([]byte, error) {
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      jsonRPCMsg["id"],
+		"result":  result,
+	}
+
+	responseBytes, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return responseBytes, nil
+}
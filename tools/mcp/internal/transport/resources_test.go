@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestResourceSubscriptionsLimitPerSession(t *testing.T) {
+	subscriptions := newResourceSubscriptions()
+	session := NewSession()
+
+	for i := 0; i < maxSubscriptionsPerSession; i++ {
+		if _, _, err := subscriptions.subscribe(fmt.Sprintf("test://%d", i), session); err != nil {
+			t.Fatalf("subscribe %d: %v", i, err)
+		}
+	}
+
+	// 重复订阅已订阅的资源不计入上限
+	if _, _, err := subscriptions.subscribe("test://0", session); err != nil {
+		t.Fatalf("resubscribe: %v", err)
+	}
+	if _, _, err := subscriptions.subscribe("test://extra", session); !errors.Is(err, errTooManySubscriptions) {
+		t.Fatalf("subscribe over limit = %v, want errTooManySubscriptions", err)
+	}
+
+	// 其他会话不受影响
+	if _, _, err := subscriptions.subscribe("test://extra", NewSession()); err != nil {
+		t.Fatalf("subscribe from another session: %v", err)
+	}
+}
+
+func TestResourceSubscriptionsRemoveSession(t *testing.T) {
+	subscriptions := newResourceSubscriptions()
+	first := NewSession()
+	second := NewSession()
+
+	if watch, firstForSession, _ := subscriptions.subscribe("test://shared", first); !watch || !firstForSession {
+		t.Fatalf("first subscribe = %v, %v, want true, true", watch, firstForSession)
+	}
+	if watch, firstForSession, _ := subscriptions.subscribe("test://own", first); !watch || firstForSession {
+		t.Fatalf("second subscribe = %v, %v, want true, false", watch, firstForSession)
+	}
+	if watch, _, _ := subscriptions.subscribe("test://shared", second); watch {
+		t.Fatal("shared resource watched twice")
+	}
+
+	// 只有不再被其他会话订阅的资源需要停止刷新
+	empty := subscriptions.removeSession(first)
+	if len(empty) != 1 || empty[0] != "test://own" {
+		t.Fatalf("removeSession = %v, want [test://own]", empty)
+	}
+	if _, exists := subscriptions.bySession[first]; exists {
+		t.Fatal("session subscriptions not removed")
+	}
+	if !subscriptions.unsubscribe("test://shared", second) {
+		t.Fatal("shared resource still has subscribers")
+	}
+}
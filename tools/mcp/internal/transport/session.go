@@ -0,0 +1,455 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// SessionHeader Streamable HTTP 规范中的会话ID请求头
+const SessionHeader = "Mcp-Session-Id"
+
+// defaultLogLevel 会话默认日志级别
+const defaultLogLevel = "info"
+
+// Session MCP会话
+// 每个客户端连接独立保存协商得到的协议版本、客户端能力和日志级别
+type Session struct {
+	ID        string
+	CreatedAt time.Time
+
+	client             string // 创建会话的客户端（对端IP），用于按客户端限制会话数
+	protocolVersion    string
+	clientCapabilities map[string]interface{}
+	clientName         string
+	clientVersion      string
+	logLevel           string
+	initialized        bool
+	lastActive         time.Time
+	events             *EventStream
+	notifyWriter       func(message []byte) error // 设置后通知直接写出，不经过事件流
+	inflight           map[string]context.CancelFunc // 进行中的请求，按请求ID取消
+	ctx                context.Context
+	cancel             context.CancelFunc
+	mutex              sync.RWMutex
+}
+
+// NewSession 创建新的会话
+func NewSession() *Session {
+	return newSession(defaultEventBufferSize)
+}
+
+// newSession 创建新的会话，eventBufferSize 为可补发的历史事件数量
+func newSession(eventBufferSize int) *Session {
+	now := time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Session{
+		ID:                 uuid.NewString(),
+		CreatedAt:          now,
+		clientCapabilities: make(map[string]interface{}),
+		logLevel:           defaultLogLevel,
+		lastActive:         now,
+		events:             NewEventStream(eventBufferSize),
+		inflight:           make(map[string]context.CancelFunc),
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+}
+
+// Initialize 记录初始化握手的协商结果
+func (s *Session) Initialize(protocolVersion string, capabilities map[string]interface{}, clientName, clientVersion string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if capabilities == nil {
+		capabilities = make(map[string]interface{})
+	}
+
+	s.protocolVersion = protocolVersion
+	s.clientCapabilities = capabilities
+	s.clientName = clientName
+	s.clientVersion = clientVersion
+	s.initialized = true
+}
+
+// IsInitialized 检查会话是否已完成初始化
+func (s *Session) IsInitialized() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.initialized
+}
+
+// GetProtocolVersion 获取协商的协议版本
+func (s *Session) GetProtocolVersion() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.protocolVersion
+}
+
+// SupportsStructuredOutput 判断会话协商的协议版本是否支持 outputSchema 和 structuredContent
+func (s *Session) SupportsStructuredOutput() bool {
+	return protocolVersionAtLeast(s.GetProtocolVersion(), protocolVersionStructuredOutput)
+}
+
+// GetClientInfo 获取客户端名称和版本
+func (s *Session) GetClientInfo() (string, string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.clientName, s.clientVersion
+}
+
+// GetClientCapabilities 获取客户端能力
+func (s *Session) GetClientCapabilities() map[string]interface{} {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.clientCapabilities
+}
+
+// GetLogLevel 获取会话日志级别
+func (s *Session) GetLogLevel() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.logLevel
+}
+
+// SetLogLevel 设置会话日志级别
+func (s *Session) SetLogLevel(level string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.logLevel = level
+}
+
+// Events 获取会话的事件流
+func (s *Session) Events() *EventStream {
+	return s.events
+}
+
+// SetNotifyWriter 设置直接写出通知的函数
+// stdio 模式下通知与响应写到同一个输出，直接写出可保证请求期间的通知先于响应到达
+func (s *Session) SetNotifyWriter(writer func(message []byte) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.notifyWriter = writer
+}
+
+// Notify 向客户端推送JSON-RPC通知
+func (s *Session) Notify(method string, params interface{}) error {
+	notification := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+	}
+	if params != nil {
+		notification["params"] = params
+	}
+
+	s.mutex.RLock()
+	writer := s.notifyWriter
+	s.mutex.RUnlock()
+	if writer != nil {
+		data, err := json.Marshal(notification)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification: %w", err)
+		}
+		return writer(data)
+	}
+
+	_, err := s.events.PublishJSON(notification)
+	return err
+}
+
+// Context 获取会话生命周期上下文，会话结束时取消
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+// TrackRequest 登记进行中的请求，返回的函数用于请求结束时注销
+func (s *Session) TrackRequest(id interface{}, cancel context.CancelFunc) func() {
+	key := requestKey(id)
+
+	s.mutex.Lock()
+	s.inflight[key] = cancel
+	s.mutex.Unlock()
+
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		delete(s.inflight, key)
+	}
+}
+
+// CancelRequest 按请求ID取消进行中的请求，请求不存在时返回 false
+func (s *Session) CancelRequest(id interface{}) bool {
+	s.mutex.Lock()
+	cancel, exists := s.inflight[requestKey(id)]
+	s.mutex.Unlock()
+
+	if !exists {
+		return false
+	}
+	cancel()
+	return true
+}
+
+// InflightCount 获取进行中的请求数量
+func (s *Session) InflightCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.inflight)
+}
+
+// close 结束会话：取消所有进行中的请求并关闭事件流
+func (s *Session) close() {
+	s.cancel()
+	s.events.Close()
+}
+
+// requestKey 将JSON-RPC请求ID转换为登记用的键，区分字符串和数字ID
+func requestKey(id interface{}) string {
+	return fmt.Sprintf("%T:%v", id, id)
+}
+
+// Touch 刷新会话最近活跃时间
+func (s *Session) Touch() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastActive = time.Now()
+}
+
+// LastActive 获取会话最近活跃时间
+func (s *Session) LastActive() time.Time {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.lastActive
+}
+
+// defaultMaxSessions 默认的会话总数上限
+const defaultMaxSessions = 1000
+
+// defaultMaxSessionsPerClient 默认的单个客户端会话数上限
+const defaultMaxSessionsPerClient = 20
+
+// SessionLimitError 会话数达到上限时拒绝创建会话的错误
+type SessionLimitError struct {
+	Reason string // max_sessions 或 max_sessions_per_client
+	Limit  int
+}
+
+// Error 实现 error 接口
+func (e *SessionLimitError) Error() string {
+	return fmt.Sprintf("too many sessions: %s (limit=%d)", e.Reason, e.Limit)
+}
+
+// Details 返回放入 JSON-RPC 错误 data 中的详细信息
+func (e *SessionLimitError) Details() map[string]interface{} {
+	return map[string]interface{}{
+		"reason": e.Reason,
+		"limit":  e.Limit,
+	}
+}
+
+// ErrorResponse 返回 JSON-RPC 错误响应，requestID 为被拒绝请求的ID，没有时为 nil
+func (e *SessionLimitError) ErrorResponse(requestID interface{}) []byte {
+	response, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      requestID,
+		"error": map[string]interface{}{
+			"code":    -32000,
+			"message": "Too many sessions",
+			"data":    e.Details(),
+		},
+	})
+	return response
+}
+
+// SessionManager 会话管理器
+type SessionManager struct {
+	sessions             map[string]*Session
+	perClient            map[string]int
+	idleTimeout          time.Duration
+	eventBufferSize      int
+	maxSessions          int
+	maxSessionsPerClient int
+	mutex                sync.RWMutex
+}
+
+// NewSessionManager 创建会话管理器
+// idleTimeout 为会话空闲过期时间，eventBufferSize 为每个会话可补发的历史事件数量；
+// maxSessions 为会话总数上限，maxSessionsPerClient 为单个客户端的会话数上限，为 0 时不限制
+func NewSessionManager(idleTimeout time.Duration, eventBufferSize, maxSessions, maxSessionsPerClient int) *SessionManager {
+	return &SessionManager{
+		sessions:             make(map[string]*Session),
+		perClient:            make(map[string]int),
+		idleTimeout:          idleTimeout,
+		eventBufferSize:      eventBufferSize,
+		maxSessions:          maxSessions,
+		maxSessionsPerClient: maxSessionsPerClient,
+	}
+}
+
+// Create 为客户端（对端IP）创建并登记新的会话
+// 会话总数或该客户端的会话数达到上限时返回 *SessionLimitError
+func (m *SessionManager) Create(client string) (*Session, error) {
+	m.mutex.Lock()
+	if m.maxSessions > 0 && len(m.sessions) >= m.maxSessions {
+		m.mutex.Unlock()
+		return nil, &SessionLimitError{Reason: "max_sessions", Limit: m.maxSessions}
+	}
+	if m.maxSessionsPerClient > 0 && m.perClient[client] >= m.maxSessionsPerClient {
+		m.mutex.Unlock()
+		return nil, &SessionLimitError{Reason: "max_sessions_per_client", Limit: m.maxSessionsPerClient}
+	}
+
+	session := newSession(m.eventBufferSize)
+	session.client = client
+	m.sessions[session.ID] = session
+	m.perClient[client]++
+	m.mutex.Unlock()
+
+	logger.WithFields(logrus.Fields{
+		"session_id": session.ID,
+		"client":     client,
+	}).Debug("Session created")
+	return session, nil
+}
+
+// Attach 登记由传输层自行创建的会话（如 stdio 连接），使其能收到广播通知
+func (m *SessionManager) Attach(session *Session) {
+	m.mutex.Lock()
+	m.sessions[session.ID] = session
+	m.mutex.Unlock()
+}
+
+// List 获取当前所有会话
+func (m *SessionManager) List() []*Session {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	sessions := make([]*Session, 0, len(m.sessions))
+	for _, session := range m.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// Get 获取会话并刷新其活跃时间
+func (m *SessionManager) Get(id string) (*Session, bool) {
+	m.mutex.RLock()
+	session, exists := m.sessions[id]
+	m.mutex.RUnlock()
+
+	if !exists {
+		return nil, false
+	}
+
+	session.Touch()
+	return session, true
+}
+
+// Delete 结束会话
+func (m *SessionManager) Delete(id string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	session, exists := m.sessions[id]
+	if !exists {
+		return false
+	}
+	m.removeLocked(session)
+
+	logger.WithFields(logrus.Fields{
+		"session_id": id,
+	}).Debug("Session deleted")
+	return true
+}
+
+// removeLocked 移除并结束会话，调用方需持有锁
+func (m *SessionManager) removeLocked(session *Session) {
+	delete(m.sessions, session.ID)
+	if session.client != "" {
+		if m.perClient[session.client]--; m.perClient[session.client] <= 0 {
+			delete(m.perClient, session.client)
+		}
+	}
+	session.close()
+}
+
+// Count 获取当前会话数量
+func (m *SessionManager) Count() int {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return len(m.sessions)
+}
+
+// ExpireIdle 清理超过空闲时间的会话，返回清理数量
+func (m *SessionManager) ExpireIdle() int {
+	if m.idleTimeout <= 0 {
+		return 0
+	}
+
+	deadline := time.Now().Add(-m.idleTimeout)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	expired := 0
+	for _, session := range m.sessions {
+		if session.LastActive().Before(deadline) {
+			m.removeLocked(session)
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		logger.WithFields(logrus.Fields{
+			"expired_sessions": expired,
+			"active_sessions":  len(m.sessions),
+		}).Info("Expired idle sessions")
+	}
+	return expired
+}
+
+// StartCleanup 定期清理空闲会话，ctx 取消时退出
+func (m *SessionManager) StartCleanup(ctx context.Context) {
+	if m.idleTimeout <= 0 {
+		return
+	}
+
+	interval := m.idleTimeout / 2
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.ExpireIdle()
+			}
+		}
+	}()
+}
+
+// sessionContextKey 会话在上下文中的键
+type sessionContextKey struct{}
+
+// WithSession 将会话放入上下文
+func WithSession(ctx context.Context, session *Session) context.Context {
+	return context.WithValue(ctx, sessionContextKey{}, session)
+}
+
+// SessionFromContext 从上下文中获取会话
+func SessionFromContext(ctx context.Context) *Session {
+	session, _ := ctx.Value(sessionContextKey{}).(*Session)
+	return session
+}
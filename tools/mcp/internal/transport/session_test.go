@@ -0,0 +1,67 @@
+package transport
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// createSession 创建会话并断言成功
+func createSession(t *testing.T, m *SessionManager, client string) *Session {
+	t.Helper()
+	session, err := m.Create(client)
+	if err != nil {
+		t.Fatalf("create session for %s: %v", client, err)
+	}
+	return session
+}
+
+// assertSessionLimit 断言创建会话因指定原因被拒绝
+func assertSessionLimit(t *testing.T, m *SessionManager, client, reason string) {
+	t.Helper()
+	_, err := m.Create(client)
+	var limitErr *SessionLimitError
+	if !errors.As(err, &limitErr) || limitErr.Reason != reason {
+		t.Fatalf("create session for %s = %v, want %s", client, err, reason)
+	}
+}
+
+func TestSessionManagerLimitsSessionsPerClient(t *testing.T) {
+	m := NewSessionManager(time.Hour, 10, 10, 2)
+
+	first := createSession(t, m, "10.0.0.1")
+	createSession(t, m, "10.0.0.1")
+	assertSessionLimit(t, m, "10.0.0.1", "max_sessions_per_client")
+
+	// 其他客户端不受影响
+	createSession(t, m, "10.0.0.2")
+
+	// 删除会话后名额归还
+	m.Delete(first.ID)
+	createSession(t, m, "10.0.0.1")
+}
+
+func TestSessionManagerLimitsTotalSessions(t *testing.T) {
+	m := NewSessionManager(time.Hour, 10, 2, 0)
+
+	createSession(t, m, "10.0.0.1")
+	createSession(t, m, "10.0.0.2")
+	assertSessionLimit(t, m, "10.0.0.3", "max_sessions")
+}
+
+func TestSessionManagerExpireReleasesClientSessions(t *testing.T) {
+	m := NewSessionManager(time.Minute, 10, 10, 1)
+
+	session := createSession(t, m, "10.0.0.1")
+	session.mutex.Lock()
+	session.lastActive = time.Now().Add(-time.Hour)
+	session.mutex.Unlock()
+
+	if expired := m.ExpireIdle(); expired != 1 {
+		t.Fatalf("ExpireIdle = %d, want 1", expired)
+	}
+	if session.Context().Err() == nil {
+		t.Fatal("expired session context not cancelled")
+	}
+	createSession(t, m, "10.0.0.1")
+}
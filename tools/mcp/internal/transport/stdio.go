@@ -0,0 +1,130 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// maxStdioMessageSize 单条 stdio 消息的最大长度
+const maxStdioMessageSize = 10 * 1024 * 1024
+
+// StdioTransport 基于换行分隔 JSON-RPC 的 stdio 传输层
+// 每行一条 JSON-RPC 消息，统一交给 MCPMessageHandler 处理，与 HTTP 模式共用同一套协议实现
+type StdioTransport struct {
+	handler *MCPMessageHandler
+	session *Session // stdio 连接对应唯一的会话，不参与空闲过期
+	reader  io.Reader
+	writer  io.Writer
+	writeMu sync.Mutex
+}
+
+// NewStdioTransport 创建 stdio 传输层
+func NewStdioTransport(handler *MCPMessageHandler, reader io.Reader, writer io.Writer) *StdioTransport {
+	return &StdioTransport{
+		handler: handler,
+		session: NewSession(),
+		reader:  reader,
+		writer:  writer,
+	}
+}
+
+// Serve 循环读取标准输入并处理消息，输入结束时返回 nil
+func (t *StdioTransport) Serve(ctx context.Context) error {
+	ctx = WithSession(ctx, t.session)
+
+	// 服务器发起的通知直接写到标准输出，请求期间的进度和日志通知先于响应到达
+	t.session.SetNotifyWriter(t.Send)
+	defer t.session.SetNotifyWriter(nil)
+
+	// 登记到会话管理器以接收广播通知（如工具列表变化），stdio 模式不清理空闲会话
+	if sessions := t.handler.Sessions(); sessions != nil {
+		sessions.Attach(t.session)
+		defer sessions.Delete(t.session.ID)
+	}
+
+	scanner := bufio.NewScanner(t.reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStdioMessageSize)
+
+	// 请求并发处理，输入结束后等待所有进行中的请求完成再返回
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		message := make([]byte, len(line))
+		copy(message, line)
+
+		// 初始化完成前按顺序处理，保证 initialize 先于后续请求生效
+		if !t.session.IsInitialized() {
+			t.handleLine(ctx, message)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t.handleLine(ctx, message)
+		}()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read stdio message: %w", err)
+	}
+
+	logger.Info("Stdio input closed")
+	return nil
+}
+
+// handleLine 处理单条消息并写回响应
+func (t *StdioTransport) handleLine(ctx context.Context, message []byte) {
+	response, err := t.handler.HandleMessage(ctx, message)
+	if err != nil {
+		// 协议错误已由 HandleMessage 转换为 JSON-RPC 错误响应，这里只剩内部错误
+		response, err = t.handler.createErrorResponse(nil, -32603, "Internal error", map[string]interface{}{
+			"details": err.Error(),
+		})
+		if err != nil {
+			logger.WithError(err).Error("Failed to create internal error response")
+			return
+		}
+	}
+
+	// 通知消息没有响应
+	if response == nil {
+		return
+	}
+
+	if err := t.Send(response); err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("Failed to write stdio response")
+	}
+}
+
+// Send 向标准输出写入一条消息
+func (t *StdioTransport) Send(message []byte) error {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	if _, err := t.writer.Write(append(message, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
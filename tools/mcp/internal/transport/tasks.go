@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// TaskSubmitter 异步任务提交接口，避免循环依赖
+// SubmitTask 以 ctx 中的调用方标识作为任务所有者，返回任务信息的 JSON 对象；
+// 工具不存在、回调地址无效或任务数达到上限时返回 ToolExecutionError
+type TaskSubmitter interface {
+	SubmitTask(ctx context.Context, toolName string, arguments map[string]interface{}, callbackURL string) (map[string]interface{}, error)
+}
+
+// SetTaskSubmitter 设置异步任务提交器，设置后 tools/call 支持 _meta.async
+func (h *MCPMessageHandler) SetTaskSubmitter(submitter TaskSubmitter) {
+	h.tasks = submitter
+}
+
+// asyncOptionsFromParams 读取 tools/call 请求 _meta 中的 async 和 callback_url
+func asyncOptionsFromParams(params map[string]interface{}) (bool, string) {
+	meta, ok := params["_meta"].(map[string]interface{})
+	if !ok {
+		return false, ""
+	}
+	async, _ := meta["async"].(bool)
+	callbackURL, _ := meta["callback_url"].(string)
+	return async, callbackURL
+}
+
+// submitAsyncToolCall 提交异步工具调用，结果的 content 和 _meta.task 为任务信息，可通过 get_task_result 工具查询结果
+func (h *MCPMessageHandler) submitAsyncToolCall(ctx context.Context, jsonRPCMsg map[string]interface{}, toolName string, arguments map[string]interface{}, callbackURL string) ([]byte, error) {
+	if h.tasks == nil {
+		return h.createErrorResponse(jsonRPCMsg, -32602, "Async tool calls are not supported", nil)
+	}
+
+	task, err := h.tasks.SubmitTask(ctx, toolName, arguments, callbackURL)
+	var toolErr ToolExecutionError
+	if errors.As(err, &toolErr) {
+		logger.WithFields(logrus.Fields{
+			"tool_name": toolName,
+			"category":  toolErr.ErrorCategory(),
+			"error":     toolErr.Error(),
+		}).Warn("Rejected async tool call")
+		switch toolErr.ErrorCategory() {
+		case "not_found":
+			return h.createErrorResponse(jsonRPCMsg, -32602, "Unknown tool", map[string]interface{}{
+				"tool": toolName,
+			})
+		case "invalid_argument":
+			return h.createErrorResponse(jsonRPCMsg, -32602, "Invalid params", map[string]interface{}{
+				"details": toolErr.Error(),
+			})
+		default:
+			return h.createErrorResponse(jsonRPCMsg, -32000, "Server busy", map[string]interface{}{
+				"details": toolErr.Error(),
+			})
+		}
+	}
+	if err != nil {
+		return h.createErrorResponse(jsonRPCMsg, -32603, "Internal error", map[string]interface{}{
+			"details": err.Error(),
+		})
+	}
+
+	text, err := json.MarshalIndent(task, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      jsonRPCMsg["id"],
+		"result": map[string]interface{}{
+			"content": []map[string]interface{}{
+				{
+					"type": "text",
+					"text": string(text),
+				},
+			},
+			"_meta": map[string]interface{}{
+				"task": task,
+			},
+		},
+	}
+
+	responseBytes, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal async tool call response: %w", err)
+	}
+	return responseBytes, nil
+}
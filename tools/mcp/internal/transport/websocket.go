@@ -0,0 +1,556 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+	"ai-sre/tools/mcp/internal/caller"
+	"ai-sre/tools/mcp/pkg/logger"
+)
+
+// WebSocketSubprotocol 握手时协商的子协议，客户端未声明子协议时也可连接
+const WebSocketSubprotocol = "mcp"
+
+// websocketAcceptGUID RFC 6455 中计算 Sec-WebSocket-Accept 使用的固定GUID
+const websocketAcceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxWebSocketMessageSize 单条 WebSocket 消息的最大长度，与 stdio 一致
+const maxWebSocketMessageSize = maxStdioMessageSize
+
+// websocketWriteTimeout 单个帧的写超时，避免客户端不读取时阻塞推送
+const websocketWriteTimeout = 10 * time.Second
+
+// websocketMaxInflight 单个连接同时处理的消息数上限，超出时请求直接返回服务繁忙
+const websocketMaxInflight = 16
+
+// WebSocket 帧类型
+const (
+	websocketOpContinuation = 0x0
+	websocketOpText         = 0x1
+	websocketOpBinary       = 0x2
+	websocketOpClose        = 0x8
+	websocketOpPing         = 0x9
+	websocketOpPong         = 0xA
+)
+
+// WebSocket 关闭状态码
+const (
+	websocketCloseNormal        = 1000
+	websocketCloseGoingAway     = 1001
+	websocketCloseProtocolError = 1002
+	websocketCloseUnsupported   = 1003
+	websocketCloseInvalidData   = 1007
+	websocketCloseTooLarge      = 1009
+)
+
+// websocketCloseError 对端发送关闭帧或因协议错误需要关闭连接
+type websocketCloseError struct {
+	code   int
+	reason string
+}
+
+// Error 实现 error 接口
+func (e *websocketCloseError) Error() string {
+	return fmt.Sprintf("websocket closed: %d %s", e.code, e.reason)
+}
+
+// WebSocketConn 服务端 WebSocket 连接（RFC 6455）
+// 只实现 MCP 需要的部分：文本消息（支持分片）、ping/pong 和关闭握手，不支持扩展（如 permessage-deflate）
+type WebSocketConn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	writeMu sync.Mutex
+	onPong  func()
+	closed  bool
+}
+
+// UpgradeWebSocket 完成 WebSocket 握手，返回建立的连接
+// allowedOrigins 为同主机之外允许的来源，来源不被允许时返回 403，防止网页跨站建立连接；
+// 握手失败时已向客户端写回错误响应，调用方直接返回即可
+func UpgradeWebSocket(w http.ResponseWriter, r *http.Request, allowedOrigins []string) (*WebSocketConn, error) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return nil, fmt.Errorf("websocket upgrade requires GET, got %s", r.Method)
+	}
+	if !headerContainsToken(r.Header, "Connection", "upgrade") || !headerContainsToken(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "Expected WebSocket upgrade", http.StatusUpgradeRequired)
+		return nil, fmt.Errorf("missing websocket upgrade headers")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, fmt.Errorf("unsupported websocket version: %s", r.Header.Get("Sec-WebSocket-Version"))
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		http.Error(w, "Invalid Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, fmt.Errorf("invalid Sec-WebSocket-Key")
+	}
+	if origin := r.Header.Get("Origin"); !websocketOriginAllowed(origin, r.Host, allowedOrigins) {
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return nil, fmt.Errorf("websocket origin not allowed: %s", origin)
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "WebSocket not supported", http.StatusInternalServerError)
+		return nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	conn, buffered, err := hijacker.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hijack connection: %w", err)
+	}
+
+	// 接管后的连接不再受 HTTP 服务器读写超时的限制，由传输层自行设置
+	conn.SetDeadline(time.Time{})
+
+	hash := sha1.Sum([]byte(key + websocketAcceptGUID))
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(hash[:]) + "\r\n"
+	if headerContainsToken(r.Header, "Sec-WebSocket-Protocol", WebSocketSubprotocol) {
+		response += "Sec-WebSocket-Protocol: " + WebSocketSubprotocol + "\r\n"
+	}
+	response += "\r\n"
+
+	conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout))
+	if _, err := buffered.WriteString(response); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write handshake response: %w", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write handshake response: %w", err)
+	}
+	conn.SetWriteDeadline(time.Time{})
+
+	return &WebSocketConn{
+		conn:   conn,
+		reader: buffered.Reader,
+	}, nil
+}
+
+// websocketOriginAllowed 判断握手请求的来源是否允许
+// 浏览器总会发送 Origin，不带 Origin 的请求来自非浏览器客户端，不受跨站限制；
+// 来源主机与请求的 Host 相同，或在 allowedOrigins 中（"*" 表示全部），视为允许
+func websocketOriginAllowed(origin, host string, allowedOrigins []string) bool {
+	if origin == "" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	if strings.EqualFold(parsed.Host, host) {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		allowed = strings.TrimSuffix(strings.TrimSpace(allowed), "/")
+		if allowed == "*" || strings.EqualFold(allowed, parsed.Scheme+"://"+parsed.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// headerContainsToken 判断逗号分隔的请求头中是否包含指定值（不区分大小写）
+func headerContainsToken(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetReadDeadline 设置读超时，超时后 ReadMessage 返回错误
+func (c *WebSocketConn) SetReadDeadline(deadline time.Time) error {
+	return c.conn.SetReadDeadline(deadline)
+}
+
+// ReadMessage 读取一条完整的文本消息，期间自动回复 ping、处理 pong
+// 对端关闭连接或发生协议错误时返回错误，协议错误会先发送对应的关闭帧
+func (c *WebSocketConn) ReadMessage() ([]byte, error) {
+	var message []byte
+	started := false
+
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			var closeErr *websocketCloseError
+			if errors.As(err, &closeErr) {
+				c.Close(closeErr.code, closeErr.reason)
+			}
+			return nil, err
+		}
+
+		switch opcode {
+		case websocketOpPing:
+			if err := c.writeFrame(websocketOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case websocketOpPong:
+			if c.onPong != nil {
+				c.onPong()
+			}
+			continue
+		case websocketOpClose:
+			code := websocketCloseNormal
+			if len(payload) >= 2 {
+				code = int(binary.BigEndian.Uint16(payload))
+			}
+			c.Close(code, "")
+			return nil, &websocketCloseError{code: code}
+		case websocketOpBinary:
+			c.Close(websocketCloseUnsupported, "binary messages are not supported")
+			return nil, &websocketCloseError{code: websocketCloseUnsupported, reason: "binary message"}
+		case websocketOpText:
+			if started {
+				c.Close(websocketCloseProtocolError, "unexpected text frame")
+				return nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "unexpected text frame"}
+			}
+			started = true
+		case websocketOpContinuation:
+			if !started {
+				c.Close(websocketCloseProtocolError, "unexpected continuation frame")
+				return nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "unexpected continuation frame"}
+			}
+		default:
+			c.Close(websocketCloseProtocolError, "unknown opcode")
+			return nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "unknown opcode"}
+		}
+
+		if len(message)+len(payload) > maxWebSocketMessageSize {
+			c.Close(websocketCloseTooLarge, "message too large")
+			return nil, &websocketCloseError{code: websocketCloseTooLarge, reason: "message too large"}
+		}
+		message = append(message, payload...)
+
+		if fin {
+			if !utf8.Valid(message) {
+				c.Close(websocketCloseInvalidData, "invalid UTF-8")
+				return nil, &websocketCloseError{code: websocketCloseInvalidData, reason: "invalid UTF-8"}
+			}
+			return message, nil
+		}
+	}
+}
+
+// readFrame 读取一个帧并去掉客户端掩码
+func (c *WebSocketConn) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7F)
+
+	if header[0]&0x70 != 0 {
+		return false, 0, nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "reserved bits set"}
+	}
+	// 客户端发送的帧必须带掩码
+	if !masked {
+		return false, 0, nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "unmasked client frame"}
+	}
+	if opcode >= websocketOpClose && (!fin || length > 125) {
+		return false, 0, nil, &websocketCloseError{code: websocketCloseProtocolError, reason: "invalid control frame"}
+	}
+
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	if length > maxWebSocketMessageSize {
+		return false, 0, nil, &websocketCloseError{code: websocketCloseTooLarge, reason: "message too large"}
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// WriteMessage 发送一条文本消息，可并发调用
+func (c *WebSocketConn) WriteMessage(message []byte) error {
+	return c.writeFrame(websocketOpText, message)
+}
+
+// Ping 发送 ping 帧，对端回复的 pong 通过 SetPongHandler 设置的回调通知
+func (c *WebSocketConn) Ping() error {
+	return c.writeFrame(websocketOpPing, nil)
+}
+
+// SetPongHandler 设置收到 pong 时的回调，在 ReadMessage 所在的 goroutine 中调用
+func (c *WebSocketConn) SetPongHandler(handler func()) {
+	c.onPong = handler
+}
+
+// writeFrame 写出一个不分片、不带掩码的帧
+func (c *WebSocketConn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.closed {
+		return net.ErrClosed
+	}
+
+	frame := make([]byte, 0, len(payload)+10)
+	frame = append(frame, 0x80|opcode)
+	switch length := len(payload); {
+	case length <= 125:
+		frame = append(frame, byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, 127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+	frame = append(frame, payload...)
+
+	c.conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout))
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// Close 发送关闭帧并关闭连接，重复调用无副作用
+func (c *WebSocketConn) Close(code int, reason string) error {
+	payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+	payload = append(payload, reason...)
+	if len(payload) > 125 {
+		payload = payload[:125]
+	}
+	c.writeFrame(websocketOpClose, payload)
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.conn.Close()
+}
+
+// WebSocketTransport 基于 WebSocket 的 MCP 传输层
+// 每个文本消息为一条 JSON-RPC 消息，统一交给 MCPMessageHandler 处理；每个连接对应一个会话，
+// 服务器发起的通知（进度、日志、列表变化等）在同一连接上推送，连接断开时会话随之结束
+type WebSocketTransport struct {
+	handler      *MCPMessageHandler
+	conn         *WebSocketConn
+	session      *Session
+	pingInterval time.Duration
+}
+
+// NewWebSocketTransport 为已建立的连接创建传输层，pingInterval 为保活 ping 的发送间隔
+// session 为连接对应的会话，由调用方在升级前通过 SessionManager.Create 创建以便受会话数限制；为 nil 时创建不登记的会话
+func NewWebSocketTransport(handler *MCPMessageHandler, conn *WebSocketConn, session *Session, pingInterval time.Duration) *WebSocketTransport {
+	if session == nil {
+		session = NewSession()
+	}
+
+	return &WebSocketTransport{
+		handler:      handler,
+		conn:         conn,
+		session:      session,
+		pingInterval: pingInterval,
+	}
+}
+
+// Session 获取连接对应的会话
+func (t *WebSocketTransport) Session() *Session {
+	return t.session
+}
+
+// Serve 循环读取消息并处理，连接关闭、会话结束或 ctx 取消时返回
+// 超过两个 ping 间隔没有收到任何帧（含 pong）时视为连接失效
+func (t *WebSocketTransport) Serve(ctx context.Context) error {
+	// 请求在会话上下文中处理：连接断开或会话结束时取消进行中的请求；调用方标识沿用 ctx 中的连接对端
+	sessionCtx := WithSession(t.session.Context(), t.session)
+	if identity := caller.FromContext(ctx); identity != "" {
+		sessionCtx = caller.WithIdentity(sessionCtx, identity)
+	}
+
+	t.session.SetNotifyWriter(t.conn.WriteMessage)
+	defer t.session.SetNotifyWriter(nil)
+
+	// 服务器关闭或会话被结束时关闭连接，使读循环退出
+	done := make(chan struct{})
+	defer close(done)
+	go t.keepAlive(ctx, done)
+
+	readTimeout := 2 * t.pingInterval
+	t.conn.SetPongHandler(func() {
+		t.session.Touch()
+		t.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	// 连接断开后结束会话，取消进行中的请求并等待其返回
+	var wg sync.WaitGroup
+	inflight := make(chan struct{}, websocketMaxInflight)
+	defer func() {
+		if sessions := t.handler.Sessions(); sessions == nil || !sessions.Delete(t.session.ID) {
+			t.session.close()
+		}
+		wg.Wait()
+	}()
+
+	for {
+		t.conn.SetReadDeadline(time.Now().Add(readTimeout))
+		message, err := t.conn.ReadMessage()
+		if err != nil {
+			t.conn.Close(websocketCloseGoingAway, "")
+			var closeErr *websocketCloseError
+			var netErr net.Error
+			switch {
+			case errors.As(err, &closeErr), errors.Is(err, io.EOF), errors.Is(err, net.ErrClosed):
+				return nil
+			case errors.As(err, &netErr) && netErr.Timeout():
+				return fmt.Errorf("websocket keepalive timed out: no frames received within %s", readTimeout)
+			default:
+				return fmt.Errorf("failed to read websocket message: %w", err)
+			}
+		}
+		t.session.Touch()
+
+		// 初始化完成前按顺序处理，保证 initialize 先于后续请求生效
+		if !t.session.IsInitialized() {
+			t.handleMessage(sessionCtx, message)
+			continue
+		}
+
+		select {
+		case inflight <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-inflight }()
+				t.handleMessage(sessionCtx, message)
+			}()
+		default:
+			t.rejectBusy(sessionCtx, message)
+		}
+	}
+}
+
+// rejectBusy 连接同时处理的消息已达上限时拒绝请求
+// 通知（如 notifications/cancelled）没有响应可用于拒绝，在读循环中直接处理，处理期间暂停读取新消息
+func (t *WebSocketTransport) rejectBusy(ctx context.Context, message []byte) {
+	var request map[string]interface{}
+	if err := json.Unmarshal(message, &request); err == nil {
+		if _, hasID := request["id"]; !hasID {
+			t.handleMessage(ctx, message)
+			return
+		}
+	}
+
+	response, err := t.handler.createErrorResponse(request, -32000, "Server busy", map[string]interface{}{
+		"reason": "connection_inflight_full",
+		"limit":  websocketMaxInflight,
+	})
+	if err != nil {
+		logger.WithError(err).Error("Failed to create busy error response")
+		return
+	}
+	if err := t.conn.WriteMessage(response); err != nil {
+		logger.WithFields(logrus.Fields{
+			"session_id": t.session.ID,
+			"error":      err.Error(),
+		}).Warn("Failed to write websocket response")
+	}
+}
+
+// keepAlive 定期发送 ping，ctx 取消或会话结束时关闭连接
+func (t *WebSocketTransport) keepAlive(ctx context.Context, done <-chan struct{}) {
+	ticker := time.NewTicker(t.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			t.conn.Close(websocketCloseGoingAway, "server shutting down")
+			return
+		case <-t.session.Context().Done():
+			t.conn.Close(websocketCloseNormal, "session closed")
+			return
+		case <-ticker.C:
+			if err := t.conn.Ping(); err != nil {
+				logger.WithFields(logrus.Fields{
+					"session_id": t.session.ID,
+					"error":      err.Error(),
+				}).Debug("Failed to send websocket ping")
+				t.conn.Close(websocketCloseGoingAway, "")
+				return
+			}
+		}
+	}
+}
+
+// handleMessage 处理单条消息并写回响应
+func (t *WebSocketTransport) handleMessage(ctx context.Context, message []byte) {
+	response, err := t.handler.HandleMessage(ctx, message)
+	if err != nil {
+		// 协议错误已由 HandleMessage 转换为 JSON-RPC 错误响应，这里只剩内部错误
+		response, err = t.handler.createErrorResponse(nil, -32603, "Internal error", map[string]interface{}{
+			"details": err.Error(),
+		})
+		if err != nil {
+			logger.WithError(err).Error("Failed to create internal error response")
+			return
+		}
+	}
+
+	// 通知消息没有响应
+	if response == nil {
+		return
+	}
+
+	if err := t.conn.WriteMessage(response); err != nil {
+		logger.WithFields(logrus.Fields{
+			"session_id": t.session.ID,
+			"error":      err.Error(),
+		}).Warn("Failed to write websocket response")
+	}
+}
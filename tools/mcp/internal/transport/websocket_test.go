@@ -0,0 +1,397 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestWebSocketConn 基于内存管道创建服务端连接，返回客户端一侧
+func newTestWebSocketConn(t *testing.T) (*WebSocketConn, net.Conn) {
+	t.Helper()
+	server, client := net.Pipe()
+	t.Cleanup(func() {
+		server.Close()
+		client.Close()
+	})
+	client.SetDeadline(time.Now().Add(5 * time.Second))
+	return &WebSocketConn{conn: server, reader: bufio.NewReader(server)}, client
+}
+
+// clientFrame 构造客户端帧，masked 为 false 时不带掩码（违反协议）
+func clientFrame(fin bool, opcode byte, payload []byte, masked bool) []byte {
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	frame := []byte{first}
+
+	var maskBit byte
+	if masked {
+		maskBit = 0x80
+	}
+	switch length := len(payload); {
+	case length <= 125:
+		frame = append(frame, maskBit|byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, maskBit|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	if !masked {
+		return append(frame, payload...)
+	}
+	mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	return frame
+}
+
+// writeFrames 在后台依次写出帧，服务端提前关闭连接时忽略写错误
+func writeFrames(client net.Conn, frames ...[]byte) {
+	go func() {
+		for _, frame := range frames {
+			if _, err := client.Write(frame); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// readServerFrame 读取服务端发送的一个帧，服务端的帧不带掩码且不分片
+func readServerFrame(t *testing.T, client net.Conn) (byte, []byte) {
+	t.Helper()
+	var header [2]byte
+	if _, err := io.ReadFull(client, header[:]); err != nil {
+		t.Fatalf("read frame header: %v", err)
+	}
+	if header[0]&0x80 == 0 {
+		t.Fatalf("server frame is fragmented")
+	}
+	if header[1]&0x80 != 0 {
+		t.Fatalf("server frame is masked")
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(client, extended[:]); err != nil {
+			t.Fatalf("read extended length: %v", err)
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(client, extended[:]); err != nil {
+			t.Fatalf("read extended length: %v", err)
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(client, payload); err != nil {
+		t.Fatalf("read frame payload: %v", err)
+	}
+	return header[0] & 0x0F, payload
+}
+
+// readMessageResult ReadMessage 的返回值
+type readMessageResult struct {
+	message []byte
+	err     error
+}
+
+// readMessageAsync 在后台读取一条消息，服务端回复的帧由测试在客户端一侧读取
+func readMessageAsync(conn *WebSocketConn) <-chan readMessageResult {
+	result := make(chan readMessageResult, 1)
+	go func() {
+		message, err := conn.ReadMessage()
+		result <- readMessageResult{message: message, err: err}
+	}()
+	return result
+}
+
+// expectClose 读取服务端的关闭帧并检查状态码，同时检查 ReadMessage 返回的关闭错误
+func expectClose(t *testing.T, client net.Conn, result <-chan readMessageResult, code int) {
+	t.Helper()
+	opcode, payload := readServerFrame(t, client)
+	if opcode != websocketOpClose {
+		t.Fatalf("opcode = %#x, want close", opcode)
+	}
+	if len(payload) < 2 || int(binary.BigEndian.Uint16(payload)) != code {
+		t.Fatalf("close payload = %v, want code %d", payload, code)
+	}
+
+	select {
+	case r := <-result:
+		var closeErr *websocketCloseError
+		if !errors.As(r.err, &closeErr) || closeErr.code != code {
+			t.Fatalf("ReadMessage error = %v, want close code %d", r.err, code)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ReadMessage did not return")
+	}
+}
+
+func TestWebSocketReadFragmentedMessage(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	// 分片之间允许插入控制帧
+	writeFrames(client,
+		clientFrame(false, websocketOpText, []byte("hel"), true),
+		clientFrame(true, websocketOpPing, []byte("p"), true),
+		clientFrame(false, websocketOpContinuation, []byte("l"), true),
+		clientFrame(true, websocketOpContinuation, []byte("o"), true),
+	)
+
+	opcode, payload := readServerFrame(t, client)
+	if opcode != websocketOpPong || string(payload) != "p" {
+		t.Fatalf("got opcode %#x payload %q, want pong %q", opcode, payload, "p")
+	}
+
+	r := <-result
+	if r.err != nil {
+		t.Fatalf("ReadMessage: %v", r.err)
+	}
+	if string(r.message) != "hello" {
+		t.Fatalf("message = %q, want %q", r.message, "hello")
+	}
+}
+
+func TestWebSocketReadMaskedExtendedLength(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	message := make([]byte, 300)
+	for i := range message {
+		message[i] = 'a' + byte(i%26)
+	}
+	writeFrames(client, clientFrame(true, websocketOpText, message, true))
+
+	r := <-result
+	if r.err != nil {
+		t.Fatalf("ReadMessage: %v", r.err)
+	}
+	if string(r.message) != string(message) {
+		t.Fatalf("message was not unmasked correctly")
+	}
+}
+
+func TestWebSocketRejectsUnmaskedFrame(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	writeFrames(client, clientFrame(true, websocketOpText, []byte("hi"), false))
+	expectClose(t, client, result, websocketCloseProtocolError)
+}
+
+func TestWebSocketRejectsOversizedFrame(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	// 只发送帧头，长度超过上限时不应读取（或分配）负载
+	header := []byte{0x80 | websocketOpText, 0x80 | 127}
+	header = binary.BigEndian.AppendUint64(header, maxWebSocketMessageSize+1)
+	writeFrames(client, header)
+	expectClose(t, client, result, websocketCloseTooLarge)
+}
+
+func TestWebSocketRejectsOversizedFragmentedMessage(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	// 单个分片未超过上限，合计超过
+	fragment := make([]byte, maxWebSocketMessageSize/2+1)
+	writeFrames(client,
+		clientFrame(false, websocketOpText, fragment, true),
+		clientFrame(true, websocketOpContinuation, fragment, true),
+	)
+	expectClose(t, client, result, websocketCloseTooLarge)
+}
+
+func TestWebSocketRejectsInvalidControlFrames(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame []byte
+	}{
+		{"fragmented ping", clientFrame(false, websocketOpPing, nil, true)},
+		{"ping payload too long", clientFrame(true, websocketOpPing, make([]byte, 126), true)},
+		{"unknown opcode", clientFrame(true, 0x3, nil, true)},
+		{"continuation without start", clientFrame(true, websocketOpContinuation, []byte("x"), true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, client := newTestWebSocketConn(t)
+			result := readMessageAsync(conn)
+
+			writeFrames(client, tt.frame)
+			expectClose(t, client, result, websocketCloseProtocolError)
+		})
+	}
+}
+
+func TestWebSocketRejectsTextFrameInsideFragmentedMessage(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	writeFrames(client,
+		clientFrame(false, websocketOpText, []byte("a"), true),
+		clientFrame(true, websocketOpText, []byte("b"), true),
+	)
+	expectClose(t, client, result, websocketCloseProtocolError)
+}
+
+func TestWebSocketRejectsBinaryMessage(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	writeFrames(client, clientFrame(true, websocketOpBinary, []byte{0x01}, true))
+	expectClose(t, client, result, websocketCloseUnsupported)
+}
+
+func TestWebSocketRejectsInvalidUTF8(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	writeFrames(client, clientFrame(true, websocketOpText, []byte{0xff, 0xfe}, true))
+	expectClose(t, client, result, websocketCloseInvalidData)
+}
+
+func TestWebSocketEchoesCloseFrame(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	result := readMessageAsync(conn)
+
+	payload := binary.BigEndian.AppendUint16(nil, websocketCloseGoingAway)
+	writeFrames(client, clientFrame(true, websocketOpClose, payload, true))
+	expectClose(t, client, result, websocketCloseGoingAway)
+
+	if err := conn.WriteMessage([]byte("late")); !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("WriteMessage after close = %v, want net.ErrClosed", err)
+	}
+}
+
+func TestWebSocketPongHandler(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	pongs := 0
+	conn.SetPongHandler(func() { pongs++ })
+	result := readMessageAsync(conn)
+
+	writeFrames(client,
+		clientFrame(true, websocketOpPong, nil, true),
+		clientFrame(true, websocketOpText, []byte("{}"), true),
+	)
+
+	r := <-result
+	if r.err != nil {
+		t.Fatalf("ReadMessage: %v", r.err)
+	}
+	if pongs != 1 {
+		t.Fatalf("pong handler called %d times, want 1", pongs)
+	}
+}
+
+func TestWebSocketWriteMessage(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+
+	message := make([]byte, 200)
+	for i := range message {
+		message[i] = 'x'
+	}
+	go conn.WriteMessage(message)
+
+	opcode, payload := readServerFrame(t, client)
+	if opcode != websocketOpText || string(payload) != string(message) {
+		t.Fatalf("got opcode %#x with %d bytes, want text with %d bytes", opcode, len(payload), len(message))
+	}
+}
+
+func TestWebSocketRejectsRequestWhenSaturated(t *testing.T) {
+	conn, client := newTestWebSocketConn(t)
+	ws := &WebSocketTransport{
+		handler: NewMCPMessageHandler(nil),
+		conn:    conn,
+		session: NewSession(),
+	}
+
+	go ws.rejectBusy(context.Background(), []byte(`{"jsonrpc":"2.0","id":5,"method":"tools/call"}`))
+
+	opcode, payload := readServerFrame(t, client)
+	if opcode != websocketOpText {
+		t.Fatalf("got opcode %#x, want text", opcode)
+	}
+	var response struct {
+		ID    int `json:"id"`
+		Error struct {
+			Code int `json:"code"`
+			Data struct {
+				Reason string `json:"reason"`
+				Limit  int    `json:"limit"`
+			} `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(payload, &response); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if response.ID != 5 || response.Error.Code != -32000 || response.Error.Data.Reason != "connection_inflight_full" || response.Error.Data.Limit != websocketMaxInflight {
+		t.Fatalf("response = %s", payload)
+	}
+}
+
+func TestWebSocketOriginAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		origin  string
+		allowed []string
+		want    bool
+	}{
+		{"no origin", "", nil, true},
+		{"same host", "http://mcp.example.com:8080", nil, true},
+		{"same host different case", "https://MCP.example.com:8080", nil, true},
+		{"cross origin", "https://evil.example.net", nil, false},
+		{"different port", "http://mcp.example.com:9090", nil, false},
+		{"listed origin", "https://console.example.com", []string{"https://console.example.com/"}, true},
+		{"listed origin wrong scheme", "http://console.example.com", []string{"https://console.example.com"}, false},
+		{"wildcard", "https://evil.example.net", []string{"*"}, true},
+		{"null origin", "null", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := websocketOriginAllowed(tt.origin, "mcp.example.com:8080", tt.allowed); got != tt.want {
+				t.Fatalf("websocketOriginAllowed(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradeWebSocketRejectsCrossOrigin(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "http://mcp.example.com/mcp/ws", nil)
+	r.Header.Set("Connection", "Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	r.Header.Set("Sec-WebSocket-Version", "13")
+	r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	r.Header.Set("Origin", "https://evil.example.net")
+	w := httptest.NewRecorder()
+
+	if _, err := UpgradeWebSocket(w, r, nil); err == nil {
+		t.Fatal("UpgradeWebSocket succeeded for a cross-origin request")
+	}
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,7227 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: proto/mcp/mcp.proto
+
+package mcpv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ToolCategory int32
+
+const (
+	ToolCategory_TOOL_CATEGORY_UNSPECIFIED ToolCategory = 0
+	ToolCategory_TOOL_CATEGORY_MONITORING  ToolCategory = 1
+	ToolCategory_TOOL_CATEGORY_CLOUD       ToolCategory = 2
+	ToolCategory_TOOL_CATEGORY_CONTAINER   ToolCategory = 3
+	ToolCategory_TOOL_CATEGORY_DATABASE    ToolCategory = 4
+	ToolCategory_TOOL_CATEGORY_NETWORK     ToolCategory = 5
+	ToolCategory_TOOL_CATEGORY_SECURITY    ToolCategory = 6
+)
+
+// Enum value maps for ToolCategory.
+var (
+	ToolCategory_name = map[int32]string{
+		0: "TOOL_CATEGORY_UNSPECIFIED",
+		1: "TOOL_CATEGORY_MONITORING",
+		2: "TOOL_CATEGORY_CLOUD",
+		3: "TOOL_CATEGORY_CONTAINER",
+		4: "TOOL_CATEGORY_DATABASE",
+		5: "TOOL_CATEGORY_NETWORK",
+		6: "TOOL_CATEGORY_SECURITY",
+	}
+	ToolCategory_value = map[string]int32{
+		"TOOL_CATEGORY_UNSPECIFIED": 0,
+		"TOOL_CATEGORY_MONITORING":  1,
+		"TOOL_CATEGORY_CLOUD":       2,
+		"TOOL_CATEGORY_CONTAINER":   3,
+		"TOOL_CATEGORY_DATABASE":    4,
+		"TOOL_CATEGORY_NETWORK":     5,
+		"TOOL_CATEGORY_SECURITY":    6,
+	}
+)
+
+func (x ToolCategory) Enum() *ToolCategory {
+	p := new(ToolCategory)
+	*p = x
+	return p
+}
+
+func (x ToolCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToolCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mcp_mcp_proto_enumTypes[0].Descriptor()
+}
+
+func (ToolCategory) Type() protoreflect.EnumType {
+	return &file_proto_mcp_mcp_proto_enumTypes[0]
+}
+
+func (x ToolCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToolCategory.Descriptor instead.
+func (ToolCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{0}
+}
+
+type ToolCallEventType int32
+
+const (
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_UNSPECIFIED ToolCallEventType = 0
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_STARTED     ToolCallEventType = 1
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_PROGRESS    ToolCallEventType = 2
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_COMPLETED   ToolCallEventType = 3
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_FAILED      ToolCallEventType = 4
+	ToolCallEventType_TOOL_CALL_EVENT_TYPE_LOG         ToolCallEventType = 5
+)
+
+// Enum value maps for ToolCallEventType.
+var (
+	ToolCallEventType_name = map[int32]string{
+		0: "TOOL_CALL_EVENT_TYPE_UNSPECIFIED",
+		1: "TOOL_CALL_EVENT_TYPE_STARTED",
+		2: "TOOL_CALL_EVENT_TYPE_PROGRESS",
+		3: "TOOL_CALL_EVENT_TYPE_COMPLETED",
+		4: "TOOL_CALL_EVENT_TYPE_FAILED",
+		5: "TOOL_CALL_EVENT_TYPE_LOG",
+	}
+	ToolCallEventType_value = map[string]int32{
+		"TOOL_CALL_EVENT_TYPE_UNSPECIFIED": 0,
+		"TOOL_CALL_EVENT_TYPE_STARTED":     1,
+		"TOOL_CALL_EVENT_TYPE_PROGRESS":    2,
+		"TOOL_CALL_EVENT_TYPE_COMPLETED":   3,
+		"TOOL_CALL_EVENT_TYPE_FAILED":      4,
+		"TOOL_CALL_EVENT_TYPE_LOG":         5,
+	}
+)
+
+func (x ToolCallEventType) Enum() *ToolCallEventType {
+	p := new(ToolCallEventType)
+	*p = x
+	return p
+}
+
+func (x ToolCallEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToolCallEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mcp_mcp_proto_enumTypes[1].Descriptor()
+}
+
+func (ToolCallEventType) Type() protoreflect.EnumType {
+	return &file_proto_mcp_mcp_proto_enumTypes[1]
+}
+
+func (x ToolCallEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToolCallEventType.Descriptor instead.
+func (ToolCallEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{1}
+}
+
+type ContainerStateType int32
+
+const (
+	ContainerStateType_CONTAINER_STATE_TYPE_UNSPECIFIED ContainerStateType = 0
+	ContainerStateType_CONTAINER_STATE_TYPE_WAITING     ContainerStateType = 1
+	ContainerStateType_CONTAINER_STATE_TYPE_RUNNING     ContainerStateType = 2
+	ContainerStateType_CONTAINER_STATE_TYPE_TERMINATED  ContainerStateType = 3
+)
+
+// Enum value maps for ContainerStateType.
+var (
+	ContainerStateType_name = map[int32]string{
+		0: "CONTAINER_STATE_TYPE_UNSPECIFIED",
+		1: "CONTAINER_STATE_TYPE_WAITING",
+		2: "CONTAINER_STATE_TYPE_RUNNING",
+		3: "CONTAINER_STATE_TYPE_TERMINATED",
+	}
+	ContainerStateType_value = map[string]int32{
+		"CONTAINER_STATE_TYPE_UNSPECIFIED": 0,
+		"CONTAINER_STATE_TYPE_WAITING":     1,
+		"CONTAINER_STATE_TYPE_RUNNING":     2,
+		"CONTAINER_STATE_TYPE_TERMINATED":  3,
+	}
+)
+
+func (x ContainerStateType) Enum() *ContainerStateType {
+	p := new(ContainerStateType)
+	*p = x
+	return p
+}
+
+func (x ContainerStateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerStateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mcp_mcp_proto_enumTypes[2].Descriptor()
+}
+
+func (ContainerStateType) Type() protoreflect.EnumType {
+	return &file_proto_mcp_mcp_proto_enumTypes[2]
+}
+
+func (x ContainerStateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerStateType.Descriptor instead.
+func (ContainerStateType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{2}
+}
+
+type DatabaseType int32
+
+const (
+	DatabaseType_DATABASE_TYPE_UNSPECIFIED   DatabaseType = 0
+	DatabaseType_DATABASE_TYPE_POSTGRESQL    DatabaseType = 1
+	DatabaseType_DATABASE_TYPE_MYSQL         DatabaseType = 2
+	DatabaseType_DATABASE_TYPE_MONGODB       DatabaseType = 3
+	DatabaseType_DATABASE_TYPE_REDIS         DatabaseType = 4
+	DatabaseType_DATABASE_TYPE_ELASTICSEARCH DatabaseType = 5
+)
+
+// Enum value maps for DatabaseType.
+var (
+	DatabaseType_name = map[int32]string{
+		0: "DATABASE_TYPE_UNSPECIFIED",
+		1: "DATABASE_TYPE_POSTGRESQL",
+		2: "DATABASE_TYPE_MYSQL",
+		3: "DATABASE_TYPE_MONGODB",
+		4: "DATABASE_TYPE_REDIS",
+		5: "DATABASE_TYPE_ELASTICSEARCH",
+	}
+	DatabaseType_value = map[string]int32{
+		"DATABASE_TYPE_UNSPECIFIED":   0,
+		"DATABASE_TYPE_POSTGRESQL":    1,
+		"DATABASE_TYPE_MYSQL":         2,
+		"DATABASE_TYPE_MONGODB":       3,
+		"DATABASE_TYPE_REDIS":         4,
+		"DATABASE_TYPE_ELASTICSEARCH": 5,
+	}
+)
+
+func (x DatabaseType) Enum() *DatabaseType {
+	p := new(DatabaseType)
+	*p = x
+	return p
+}
+
+func (x DatabaseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mcp_mcp_proto_enumTypes[3].Descriptor()
+}
+
+func (DatabaseType) Type() protoreflect.EnumType {
+	return &file_proto_mcp_mcp_proto_enumTypes[3]
+}
+
+func (x DatabaseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseType.Descriptor instead.
+func (DatabaseType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{3}
+}
+
+type HealthStatus int32
+
+const (
+	HealthStatus_HEALTH_STATUS_UNSPECIFIED HealthStatus = 0
+	HealthStatus_HEALTH_STATUS_HEALTHY     HealthStatus = 1
+	HealthStatus_HEALTH_STATUS_DEGRADED    HealthStatus = 2
+	HealthStatus_HEALTH_STATUS_UNHEALTHY   HealthStatus = 3
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "HEALTH_STATUS_UNSPECIFIED",
+		1: "HEALTH_STATUS_HEALTHY",
+		2: "HEALTH_STATUS_DEGRADED",
+		3: "HEALTH_STATUS_UNHEALTHY",
+	}
+	HealthStatus_value = map[string]int32{
+		"HEALTH_STATUS_UNSPECIFIED": 0,
+		"HEALTH_STATUS_HEALTHY":     1,
+		"HEALTH_STATUS_DEGRADED":    2,
+		"HEALTH_STATUS_UNHEALTHY":   3,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mcp_mcp_proto_enumTypes[4].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_proto_mcp_mcp_proto_enumTypes[4]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{4}
+}
+
+// 基础消息定义
+type Tool struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category         ToolCategory           `protobuf:"varint,3,opt,name=category,proto3,enum=ai_sre.mcp.v1.ToolCategory" json:"category,omitempty"`
+	ParametersSchema *structpb.Struct       `protobuf:"bytes,4,opt,name=parameters_schema,json=parametersSchema,proto3" json:"parameters_schema,omitempty"`
+	Examples         []*ToolExample         `protobuf:"bytes,5,rep,name=examples,proto3" json:"examples,omitempty"`
+	Metadata         *ToolMetadata          `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Tool) Reset() {
+	*x = Tool{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tool) ProtoMessage() {}
+
+func (x *Tool) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
+func (*Tool) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tool) GetCategory() ToolCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ToolCategory_TOOL_CATEGORY_UNSPECIFIED
+}
+
+func (x *Tool) GetParametersSchema() *structpb.Struct {
+	if x != nil {
+		return x.ParametersSchema
+	}
+	return nil
+}
+
+func (x *Tool) GetExamples() []*ToolExample {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *Tool) GetMetadata() *ToolMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Tool) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tool) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ToolExample struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Arguments      *structpb.Struct       `protobuf:"bytes,3,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	ExpectedResult *structpb.Struct       `protobuf:"bytes,4,opt,name=expected_result,json=expectedResult,proto3" json:"expected_result,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolExample) Reset() {
+	*x = ToolExample{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolExample) ProtoMessage() {}
+
+func (x *ToolExample) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolExample.ProtoReflect.Descriptor instead.
+func (*ToolExample) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ToolExample) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolExample) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolExample) GetArguments() *structpb.Struct {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ToolExample) GetExpectedResult() *structpb.Struct {
+	if x != nil {
+		return x.ExpectedResult
+	}
+	return nil
+}
+
+type ToolMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Version          string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Author           string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Tags             []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	UsageCount       int32                  `protobuf:"varint,4,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	AvgExecutionTime float64                `protobuf:"fixed64,5,opt,name=avg_execution_time,json=avgExecutionTime,proto3" json:"avg_execution_time,omitempty"`
+	SuccessRate      float64                `protobuf:"fixed64,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ToolMetadata) Reset() {
+	*x = ToolMetadata{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolMetadata) ProtoMessage() {}
+
+func (x *ToolMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolMetadata.ProtoReflect.Descriptor instead.
+func (*ToolMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ToolMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ToolMetadata) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ToolMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ToolMetadata) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *ToolMetadata) GetAvgExecutionTime() float64 {
+	if x != nil {
+		return x.AvgExecutionTime
+	}
+	return 0
+}
+
+func (x *ToolMetadata) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+type ListToolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      ToolCategory           `protobuf:"varint,1,opt,name=category,proto3,enum=ai_sre.mcp.v1.ToolCategory" json:"category,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolsRequest) Reset() {
+	*x = ListToolsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsRequest) ProtoMessage() {}
+
+func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListToolsRequest) GetCategory() ToolCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ToolCategory_TOOL_CATEGORY_UNSPECIFIED
+}
+
+func (x *ListToolsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListToolsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListToolsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListToolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tools         []*Tool                `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+	Pagination    *PaginationInfo        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolsResponse) Reset() {
+	*x = ListToolsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsResponse) ProtoMessage() {}
+
+func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListToolsResponse) GetTools() []*Tool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *ListToolsResponse) GetPagination() *PaginationInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetToolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolName      string                 `protobuf:"bytes,1,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolRequest) Reset() {
+	*x = GetToolRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolRequest) ProtoMessage() {}
+
+func (x *GetToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolRequest.ProtoReflect.Descriptor instead.
+func (*GetToolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetToolRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+type CallToolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolName      string                 `protobuf:"bytes,1,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	Arguments     *structpb.Struct       `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	Options       *CallOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallToolRequest) Reset() {
+	*x = CallToolRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallToolRequest) ProtoMessage() {}
+
+func (x *CallToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallToolRequest.ProtoReflect.Descriptor instead.
+func (*CallToolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CallToolRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *CallToolRequest) GetArguments() *structpb.Struct {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *CallToolRequest) GetOptions() *CallOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CallOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TimeoutSeconds int32                  `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Async          bool                   `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	CallbackUrl    string                 `protobuf:"bytes,3,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	Headers        map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CallOptions) Reset() {
+	*x = CallOptions{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallOptions) ProtoMessage() {}
+
+func (x *CallOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallOptions.ProtoReflect.Descriptor instead.
+func (*CallOptions) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CallOptions) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CallOptions) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CallOptions) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *CallOptions) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type CallToolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Result        *structpb.Struct       `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Metrics       *ToolExecutionMetrics  `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallToolResponse) Reset() {
+	*x = CallToolResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallToolResponse) ProtoMessage() {}
+
+func (x *CallToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallToolResponse.ProtoReflect.Descriptor instead.
+func (*CallToolResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CallToolResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CallToolResponse) GetResult() *structpb.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CallToolResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CallToolResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CallToolResponse) GetMetrics() *ToolExecutionMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CallToolResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ToolExecutionMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTime float64                `protobuf:"fixed64,1,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	MemoryUsed    int64                  `protobuf:"varint,2,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	CpuTime       int64                  `protobuf:"varint,3,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	NetworkCalls  int32                  `protobuf:"varint,4,opt,name=network_calls,json=networkCalls,proto3" json:"network_calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolExecutionMetrics) Reset() {
+	*x = ToolExecutionMetrics{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolExecutionMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolExecutionMetrics) ProtoMessage() {}
+
+func (x *ToolExecutionMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolExecutionMetrics.ProtoReflect.Descriptor instead.
+func (*ToolExecutionMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ToolExecutionMetrics) GetExecutionTime() float64 {
+	if x != nil {
+		return x.ExecutionTime
+	}
+	return 0
+}
+
+func (x *ToolExecutionMetrics) GetMemoryUsed() int64 {
+	if x != nil {
+		return x.MemoryUsed
+	}
+	return 0
+}
+
+func (x *ToolExecutionMetrics) GetCpuTime() int64 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *ToolExecutionMetrics) GetNetworkCalls() int32 {
+	if x != nil {
+		return x.NetworkCalls
+	}
+	return 0
+}
+
+type BatchCallToolsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Calls          []*CallToolRequest     `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	FailFast       bool                   `protobuf:"varint,2,opt,name=fail_fast,json=failFast,proto3" json:"fail_fast,omitempty"`
+	MaxConcurrency int32                  `protobuf:"varint,3,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchCallToolsRequest) Reset() {
+	*x = BatchCallToolsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCallToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCallToolsRequest) ProtoMessage() {}
+
+func (x *BatchCallToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCallToolsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCallToolsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchCallToolsRequest) GetCalls() []*CallToolRequest {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+func (x *BatchCallToolsRequest) GetFailFast() bool {
+	if x != nil {
+		return x.FailFast
+	}
+	return false
+}
+
+func (x *BatchCallToolsRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+type BatchCallToolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*CallToolResponse    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SuccessCount  int32                  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  int32                  `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCallToolsResponse) Reset() {
+	*x = BatchCallToolsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCallToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCallToolsResponse) ProtoMessage() {}
+
+func (x *BatchCallToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCallToolsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCallToolsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchCallToolsResponse) GetResults() []*CallToolResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCallToolsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchCallToolsResponse) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+type StreamToolCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolName      string                 `protobuf:"bytes,1,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	Arguments     *structpb.Struct       `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamToolCallRequest) Reset() {
+	*x = StreamToolCallRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamToolCallRequest) ProtoMessage() {}
+
+func (x *StreamToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamToolCallRequest.ProtoReflect.Descriptor instead.
+func (*StreamToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamToolCallRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *StreamToolCallRequest) GetArguments() *structpb.Struct {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+type ToolCallEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ToolCallEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=ai_sre.mcp.v1.ToolCallEventType" json:"type,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCallEvent) Reset() {
+	*x = ToolCallEvent{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCallEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallEvent) ProtoMessage() {}
+
+func (x *ToolCallEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallEvent.ProtoReflect.Descriptor instead.
+func (*ToolCallEvent) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ToolCallEvent) GetType() ToolCallEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ToolCallEventType_TOOL_CALL_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ToolCallEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ToolCallEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ToolCallEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 监控工具相关消息
+type PrometheusQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Timeout       int32                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrometheusQueryRequest) Reset() {
+	*x = PrometheusQueryRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrometheusQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusQueryRequest) ProtoMessage() {}
+
+func (x *PrometheusQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusQueryRequest.ProtoReflect.Descriptor instead.
+func (*PrometheusQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PrometheusQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PrometheusQueryRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PrometheusQueryRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type PrometheusRangeQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Step          string                 `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+	Timeout       int32                  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrometheusRangeQueryRequest) Reset() {
+	*x = PrometheusRangeQueryRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrometheusRangeQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusRangeQueryRequest) ProtoMessage() {}
+
+func (x *PrometheusRangeQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusRangeQueryRequest.ProtoReflect.Descriptor instead.
+func (*PrometheusRangeQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PrometheusRangeQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PrometheusRangeQueryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *PrometheusRangeQueryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *PrometheusRangeQueryRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *PrometheusRangeQueryRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type PrometheusQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultType    string                 `protobuf:"bytes,1,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"`
+	Results       []*PrometheusResult    `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Stats         map[string]string      `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrometheusQueryResponse) Reset() {
+	*x = PrometheusQueryResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrometheusQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusQueryResponse) ProtoMessage() {}
+
+func (x *PrometheusQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusQueryResponse.ProtoReflect.Descriptor instead.
+func (*PrometheusQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrometheusQueryResponse) GetResultType() string {
+	if x != nil {
+		return x.ResultType
+	}
+	return ""
+}
+
+func (x *PrometheusQueryResponse) GetResults() []*PrometheusResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PrometheusQueryResponse) GetStats() map[string]string {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PrometheusResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        map[string]string      `protobuf:"bytes,1,rep,name=metric,proto3" json:"metric,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Values        []*PrometheusValue     `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Value         *PrometheusValue       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrometheusResult) Reset() {
+	*x = PrometheusResult{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrometheusResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusResult) ProtoMessage() {}
+
+func (x *PrometheusResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusResult.ProtoReflect.Descriptor instead.
+func (*PrometheusResult) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrometheusResult) GetMetric() map[string]string {
+	if x != nil {
+		return x.Metric
+	}
+	return nil
+}
+
+func (x *PrometheusResult) GetValues() []*PrometheusValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PrometheusResult) GetValue() *PrometheusValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type PrometheusValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     float64                `protobuf:"fixed64,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrometheusValue) Reset() {
+	*x = PrometheusValue{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrometheusValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrometheusValue) ProtoMessage() {}
+
+func (x *PrometheusValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrometheusValue.ProtoReflect.Descriptor instead.
+func (*PrometheusValue) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PrometheusValue) GetTimestamp() float64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PrometheusValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Panels        []*Panel               `protobuf:"bytes,4,rep,name=panels,proto3" json:"panels,omitempty"`
+	Settings      *DashboardSettings     `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDashboardRequest) Reset() {
+	*x = CreateDashboardRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDashboardRequest) ProtoMessage() {}
+
+func (x *CreateDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDashboardRequest.ProtoReflect.Descriptor instead.
+func (*CreateDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateDashboardRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateDashboardRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDashboardRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateDashboardRequest) GetPanels() []*Panel {
+	if x != nil {
+		return x.Panels
+	}
+	return nil
+}
+
+func (x *CreateDashboardRequest) GetSettings() *DashboardSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Panel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	GridPos       *GridPos               `protobuf:"bytes,3,opt,name=grid_pos,json=gridPos,proto3" json:"grid_pos,omitempty"`
+	Targets       []*Target              `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+	Options       *structpb.Struct       `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Panel) Reset() {
+	*x = Panel{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Panel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Panel) ProtoMessage() {}
+
+func (x *Panel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Panel.ProtoReflect.Descriptor instead.
+func (*Panel) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Panel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Panel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Panel) GetGridPos() *GridPos {
+	if x != nil {
+		return x.GridPos
+	}
+	return nil
+}
+
+func (x *Panel) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Panel) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GridPos struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	H             int32                  `protobuf:"varint,1,opt,name=h,proto3" json:"h,omitempty"`
+	W             int32                  `protobuf:"varint,2,opt,name=w,proto3" json:"w,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GridPos) Reset() {
+	*x = GridPos{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GridPos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GridPos) ProtoMessage() {}
+
+func (x *GridPos) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GridPos.ProtoReflect.Descriptor instead.
+func (*GridPos) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GridPos) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *GridPos) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *GridPos) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GridPos) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Target struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expr          string                 `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	LegendFormat  string                 `protobuf:"bytes,2,opt,name=legend_format,json=legendFormat,proto3" json:"legend_format,omitempty"`
+	RefId         string                 `protobuf:"bytes,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Instant       bool                   `protobuf:"varint,4,opt,name=instant,proto3" json:"instant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Target) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *Target) GetLegendFormat() string {
+	if x != nil {
+		return x.LegendFormat
+	}
+	return ""
+}
+
+func (x *Target) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *Target) GetInstant() bool {
+	if x != nil {
+		return x.Instant
+	}
+	return false
+}
+
+type DashboardSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeFrom      string                 `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	TimeTo        string                 `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	Refresh       string                 `protobuf:"bytes,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DashboardSettings) Reset() {
+	*x = DashboardSettings{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardSettings) ProtoMessage() {}
+
+func (x *DashboardSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardSettings.ProtoReflect.Descriptor instead.
+func (*DashboardSettings) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DashboardSettings) GetTimeFrom() string {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return ""
+}
+
+func (x *DashboardSettings) GetTimeTo() string {
+	if x != nil {
+		return x.TimeTo
+	}
+	return ""
+}
+
+func (x *DashboardSettings) GetRefresh() string {
+	if x != nil {
+		return x.Refresh
+	}
+	return ""
+}
+
+func (x *DashboardSettings) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateDashboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDashboardResponse) Reset() {
+	*x = CreateDashboardResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDashboardResponse) ProtoMessage() {}
+
+func (x *CreateDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDashboardResponse.ProtoReflect.Descriptor instead.
+func (*CreateDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateDashboardResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateDashboardResponse) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CreateDashboardResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateDashboardResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListDashboardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDashboardsRequest) Reset() {
+	*x = ListDashboardsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDashboardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDashboardsRequest) ProtoMessage() {}
+
+func (x *ListDashboardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDashboardsRequest.ProtoReflect.Descriptor instead.
+func (*ListDashboardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDashboardsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListDashboardsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListDashboardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDashboardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dashboards    []*Dashboard           `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDashboardsResponse) Reset() {
+	*x = ListDashboardsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDashboardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDashboardsResponse) ProtoMessage() {}
+
+func (x *ListDashboardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDashboardsResponse.ProtoReflect.Descriptor instead.
+func (*ListDashboardsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDashboardsResponse) GetDashboards() []*Dashboard {
+	if x != nil {
+		return x.Dashboards
+	}
+	return nil
+}
+
+type Dashboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dashboard) Reset() {
+	*x = Dashboard{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard) ProtoMessage() {}
+
+func (x *Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Dashboard) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Dashboard) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Dashboard) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Dashboard) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Dashboard) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Dashboard) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Dashboard) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type GetAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DashboardUid  string                 `protobuf:"bytes,1,opt,name=dashboard_uid,json=dashboardUid,proto3" json:"dashboard_uid,omitempty"`
+	PanelId       string                 `protobuf:"bytes,2,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesRequest) Reset() {
+	*x = GetAlertRulesRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesRequest) ProtoMessage() {}
+
+func (x *GetAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAlertRulesRequest) GetDashboardUid() string {
+	if x != nil {
+		return x.DashboardUid
+	}
+	return ""
+}
+
+func (x *GetAlertRulesRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+type GetAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesResponse) Reset() {
+	*x = GetAlertRulesResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesResponse) ProtoMessage() {}
+
+func (x *GetAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Condition     string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Frequency     string                 `protobuf:"bytes,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Notifications []string               `protobuf:"bytes,5,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AlertRule) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AlertRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *AlertRule) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *AlertRule) GetNotifications() []string {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *AlertRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 云平台工具相关消息
+type ListEC2InstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	InstanceIds   []string               `protobuf:"bytes,2,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`
+	States        []string               `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MaxResults    int32                  `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	NextToken     string                 `protobuf:"bytes,6,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEC2InstancesRequest) Reset() {
+	*x = ListEC2InstancesRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEC2InstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEC2InstancesRequest) ProtoMessage() {}
+
+func (x *ListEC2InstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEC2InstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListEC2InstancesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListEC2InstancesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListEC2InstancesRequest) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *ListEC2InstancesRequest) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListEC2InstancesRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListEC2InstancesRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListEC2InstancesRequest) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type ListEC2InstancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []*EC2Instance         `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEC2InstancesResponse) Reset() {
+	*x = ListEC2InstancesResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEC2InstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEC2InstancesResponse) ProtoMessage() {}
+
+func (x *ListEC2InstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEC2InstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListEC2InstancesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListEC2InstancesResponse) GetInstances() []*EC2Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *ListEC2InstancesResponse) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type EC2Instance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId       string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	InstanceType     string                 `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	State            string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	PublicIp         string                 `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	PrivateIp        string                 `protobuf:"bytes,5,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`
+	PublicDns        string                 `protobuf:"bytes,6,opt,name=public_dns,json=publicDns,proto3" json:"public_dns,omitempty"`
+	PrivateDns       string                 `protobuf:"bytes,7,opt,name=private_dns,json=privateDns,proto3" json:"private_dns,omitempty"`
+	LaunchTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=launch_time,json=launchTime,proto3" json:"launch_time,omitempty"`
+	AvailabilityZone string                 `protobuf:"bytes,9,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone,omitempty"`
+	VpcId            string                 `protobuf:"bytes,10,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	SubnetId         string                 `protobuf:"bytes,11,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	Tags             map[string]string      `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SecurityGroups   []*SecurityGroup       `protobuf:"bytes,13,rep,name=security_groups,json=securityGroups,proto3" json:"security_groups,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EC2Instance) Reset() {
+	*x = EC2Instance{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EC2Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EC2Instance) ProtoMessage() {}
+
+func (x *EC2Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EC2Instance.ProtoReflect.Descriptor instead.
+func (*EC2Instance) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EC2Instance) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetPrivateIp() string {
+	if x != nil {
+		return x.PrivateIp
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetPublicDns() string {
+	if x != nil {
+		return x.PublicDns
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetPrivateDns() string {
+	if x != nil {
+		return x.PrivateDns
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetLaunchTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LaunchTime
+	}
+	return nil
+}
+
+func (x *EC2Instance) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetSubnetId() string {
+	if x != nil {
+		return x.SubnetId
+	}
+	return ""
+}
+
+func (x *EC2Instance) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EC2Instance) GetSecurityGroups() []*SecurityGroup {
+	if x != nil {
+		return x.SecurityGroups
+	}
+	return nil
+}
+
+type SecurityGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityGroup) Reset() {
+	*x = SecurityGroup{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityGroup) ProtoMessage() {}
+
+func (x *SecurityGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityGroup.ProtoReflect.Descriptor instead.
+func (*SecurityGroup) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SecurityGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SecurityGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type StartEC2InstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartEC2InstanceRequest) Reset() {
+	*x = StartEC2InstanceRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEC2InstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEC2InstanceRequest) ProtoMessage() {}
+
+func (x *StartEC2InstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEC2InstanceRequest.ProtoReflect.Descriptor instead.
+func (*StartEC2InstanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartEC2InstanceRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *StartEC2InstanceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type StopEC2InstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopEC2InstanceRequest) Reset() {
+	*x = StopEC2InstanceRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopEC2InstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopEC2InstanceRequest) ProtoMessage() {}
+
+func (x *StopEC2InstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopEC2InstanceRequest.ProtoReflect.Descriptor instead.
+func (*StopEC2InstanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StopEC2InstanceRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *StopEC2InstanceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StopEC2InstanceRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RestartEC2InstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartEC2InstanceRequest) Reset() {
+	*x = RestartEC2InstanceRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartEC2InstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartEC2InstanceRequest) ProtoMessage() {}
+
+func (x *RestartEC2InstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartEC2InstanceRequest.ProtoReflect.Descriptor instead.
+func (*RestartEC2InstanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestartEC2InstanceRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *RestartEC2InstanceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type EC2OperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	CurrentState  string                 `protobuf:"bytes,3,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	PreviousState string                 `protobuf:"bytes,4,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EC2OperationResponse) Reset() {
+	*x = EC2OperationResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EC2OperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EC2OperationResponse) ProtoMessage() {}
+
+func (x *EC2OperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EC2OperationResponse.ProtoReflect.Descriptor instead.
+func (*EC2OperationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EC2OperationResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *EC2OperationResponse) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *EC2OperationResponse) GetCurrentState() string {
+	if x != nil {
+		return x.CurrentState
+	}
+	return ""
+}
+
+func (x *EC2OperationResponse) GetPreviousState() string {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ""
+}
+
+func (x *EC2OperationResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetInstanceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceStatusRequest) Reset() {
+	*x = GetInstanceStatusRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceStatusRequest) ProtoMessage() {}
+
+func (x *GetInstanceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetInstanceStatusRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetInstanceStatusRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type InstanceStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId     string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	InstanceState  string                 `protobuf:"bytes,2,opt,name=instance_state,json=instanceState,proto3" json:"instance_state,omitempty"`
+	SystemStatus   string                 `protobuf:"bytes,3,opt,name=system_status,json=systemStatus,proto3" json:"system_status,omitempty"`
+	InstanceStatus string                 `protobuf:"bytes,4,opt,name=instance_status,json=instanceStatus,proto3" json:"instance_status,omitempty"`
+	StatusChecks   []*StatusCheck         `protobuf:"bytes,5,rep,name=status_checks,json=statusChecks,proto3" json:"status_checks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceStatusResponse) Reset() {
+	*x = InstanceStatusResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatusResponse) ProtoMessage() {}
+
+func (x *InstanceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatusResponse.ProtoReflect.Descriptor instead.
+func (*InstanceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InstanceStatusResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceStatusResponse) GetInstanceState() string {
+	if x != nil {
+		return x.InstanceState
+	}
+	return ""
+}
+
+func (x *InstanceStatusResponse) GetSystemStatus() string {
+	if x != nil {
+		return x.SystemStatus
+	}
+	return ""
+}
+
+func (x *InstanceStatusResponse) GetInstanceStatus() string {
+	if x != nil {
+		return x.InstanceStatus
+	}
+	return ""
+}
+
+func (x *InstanceStatusResponse) GetStatusChecks() []*StatusCheck {
+	if x != nil {
+		return x.StatusChecks
+	}
+	return nil
+}
+
+type StatusCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Details       []string               `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCheck) Reset() {
+	*x = StatusCheck{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCheck) ProtoMessage() {}
+
+func (x *StatusCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCheck.ProtoReflect.Descriptor instead.
+func (*StatusCheck) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StatusCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusCheck) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusCheck) GetDetails() []string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListLoadBalancersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Marker        string                 `protobuf:"bytes,4,opt,name=marker,proto3" json:"marker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoadBalancersRequest) Reset() {
+	*x = ListLoadBalancersRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoadBalancersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadBalancersRequest) ProtoMessage() {}
+
+func (x *ListLoadBalancersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadBalancersRequest.ProtoReflect.Descriptor instead.
+func (*ListLoadBalancersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListLoadBalancersRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListLoadBalancersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListLoadBalancersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoadBalancersRequest) GetMarker() string {
+	if x != nil {
+		return x.Marker
+	}
+	return ""
+}
+
+type ListLoadBalancersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoadBalancers []*LoadBalancer        `protobuf:"bytes,1,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
+	NextMarker    string                 `protobuf:"bytes,2,opt,name=next_marker,json=nextMarker,proto3" json:"next_marker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoadBalancersResponse) Reset() {
+	*x = ListLoadBalancersResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoadBalancersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadBalancersResponse) ProtoMessage() {}
+
+func (x *ListLoadBalancersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadBalancersResponse.ProtoReflect.Descriptor instead.
+func (*ListLoadBalancersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListLoadBalancersResponse) GetLoadBalancers() []*LoadBalancer {
+	if x != nil {
+		return x.LoadBalancers
+	}
+	return nil
+}
+
+func (x *ListLoadBalancersResponse) GetNextMarker() string {
+	if x != nil {
+		return x.NextMarker
+	}
+	return ""
+}
+
+type LoadBalancer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DnsName           string                 `protobuf:"bytes,2,opt,name=dns_name,json=dnsName,proto3" json:"dns_name,omitempty"`
+	Scheme            string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	State             string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Type              string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	AvailabilityZones []string               `protobuf:"bytes,6,rep,name=availability_zones,json=availabilityZones,proto3" json:"availability_zones,omitempty"`
+	SecurityGroups    []string               `protobuf:"bytes,7,rep,name=security_groups,json=securityGroups,proto3" json:"security_groups,omitempty"`
+	CreatedTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LoadBalancer) Reset() {
+	*x = LoadBalancer{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadBalancer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancer) ProtoMessage() {}
+
+func (x *LoadBalancer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancer.ProtoReflect.Descriptor instead.
+func (*LoadBalancer) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LoadBalancer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetDnsName() string {
+	if x != nil {
+		return x.DnsName
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetAvailabilityZones() []string {
+	if x != nil {
+		return x.AvailabilityZones
+	}
+	return nil
+}
+
+func (x *LoadBalancer) GetSecurityGroups() []string {
+	if x != nil {
+		return x.SecurityGroups
+	}
+	return nil
+}
+
+func (x *LoadBalancer) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+// 容器工具相关消息
+type ListPodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	FieldSelector string                 `protobuf:"bytes,3,opt,name=field_selector,json=fieldSelector,proto3" json:"field_selector,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Continue      string                 `protobuf:"bytes,5,opt,name=continue,proto3" json:"continue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPodsRequest) Reset() {
+	*x = ListPodsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodsRequest) ProtoMessage() {}
+
+func (x *ListPodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListPodsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListPodsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListPodsRequest) GetFieldSelector() string {
+	if x != nil {
+		return x.FieldSelector
+	}
+	return ""
+}
+
+func (x *ListPodsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPodsRequest) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+type ListPodsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Pods               []*Pod                 `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	Continue           string                 `protobuf:"bytes,2,opt,name=continue,proto3" json:"continue,omitempty"`
+	RemainingItemCount int32                  `protobuf:"varint,3,opt,name=remaining_item_count,json=remainingItemCount,proto3" json:"remaining_item_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListPodsResponse) Reset() {
+	*x = ListPodsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodsResponse) ProtoMessage() {}
+
+func (x *ListPodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListPodsResponse) GetPods() []*Pod {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+func (x *ListPodsResponse) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+func (x *ListPodsResponse) GetRemainingItemCount() int32 {
+	if x != nil {
+		return x.RemainingItemCount
+	}
+	return 0
+}
+
+type Pod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeName      string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	PodIp         string                 `protobuf:"bytes,5,opt,name=pod_ip,json=podIp,proto3" json:"pod_ip,omitempty"`
+	HostIp        string                 `protobuf:"bytes,6,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,9,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Containers    []*Container           `protobuf:"bytes,10,rep,name=containers,proto3" json:"containers,omitempty"`
+	Conditions    []*PodCondition        `protobuf:"bytes,11,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pod) Reset() {
+	*x = Pod{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pod) ProtoMessage() {}
+
+func (x *Pod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pod.ProtoReflect.Descriptor instead.
+func (*Pod) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Pod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pod) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Pod) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *Pod) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Pod) GetPodIp() string {
+	if x != nil {
+		return x.PodIp
+	}
+	return ""
+}
+
+func (x *Pod) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *Pod) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Pod) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Pod) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Pod) GetContainers() []*Container {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *Pod) GetConditions() []*PodCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type Container struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	State         *ContainerState        `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Ready         bool                   `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	RestartCount  int32                  `protobuf:"varint,5,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Container) GetState() *ContainerState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *Container) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Container) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+type ContainerState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ContainerStateType     `protobuf:"varint,1,opt,name=type,proto3,enum=ai_sre.mcp.v1.ContainerStateType" json:"type,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerState) Reset() {
+	*x = ContainerState{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerState) ProtoMessage() {}
+
+func (x *ContainerState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerState.ProtoReflect.Descriptor instead.
+func (*ContainerState) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ContainerState) GetType() ContainerStateType {
+	if x != nil {
+		return x.Type
+	}
+	return ContainerStateType_CONTAINER_STATE_TYPE_UNSPECIFIED
+}
+
+func (x *ContainerState) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ContainerState) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ContainerState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContainerState) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContainerState) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type PodCondition struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastProbeTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_probe_time,json=lastProbeTime,proto3" json:"last_probe_time,omitempty"`
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	Reason             string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message            string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PodCondition) Reset() {
+	*x = PodCondition{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodCondition) ProtoMessage() {}
+
+func (x *PodCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodCondition.ProtoReflect.Descriptor instead.
+func (*PodCondition) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PodCondition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PodCondition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PodCondition) GetLastProbeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProbeTime
+	}
+	return nil
+}
+
+func (x *PodCondition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+func (x *PodCondition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PodCondition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPodRequest) Reset() {
+	*x = GetPodRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPodRequest) ProtoMessage() {}
+
+func (x *GetPodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPodRequest.ProtoReflect.Descriptor instead.
+func (*GetPodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPodRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ScaleDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replicas      int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleDeploymentRequest) Reset() {
+	*x = ScaleDeploymentRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleDeploymentRequest) ProtoMessage() {}
+
+func (x *ScaleDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*ScaleDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ScaleDeploymentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaleDeploymentRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ScaleDeploymentRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type ScaleDeploymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace       string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CurrentReplicas int32                  `protobuf:"varint,3,opt,name=current_replicas,json=currentReplicas,proto3" json:"current_replicas,omitempty"`
+	DesiredReplicas int32                  `protobuf:"varint,4,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	ReadyReplicas   int32                  `protobuf:"varint,5,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScaleDeploymentResponse) Reset() {
+	*x = ScaleDeploymentResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleDeploymentResponse) ProtoMessage() {}
+
+func (x *ScaleDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*ScaleDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScaleDeploymentResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaleDeploymentResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ScaleDeploymentResponse) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+func (x *ScaleDeploymentResponse) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *ScaleDeploymentResponse) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+type GetPodLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	TailLines     int32                  `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	SinceTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	Follow        bool                   `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
+	Timestamps    bool                   `protobuf:"varint,7,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPodLogsRequest) Reset() {
+	*x = GetPodLogsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPodLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPodLogsRequest) ProtoMessage() {}
+
+func (x *GetPodLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPodLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetPodLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPodLogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPodLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetPodLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *GetPodLogsRequest) GetTailLines() int32 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *GetPodLogsRequest) GetSinceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+func (x *GetPodLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetPodLogsRequest) GetTimestamps() bool {
+	if x != nil {
+		return x.Timestamps
+	}
+	return false
+}
+
+type GetPodLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          string                 `protobuf:"bytes,1,opt,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPodLogsResponse) Reset() {
+	*x = GetPodLogsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPodLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPodLogsResponse) ProtoMessage() {}
+
+func (x *GetPodLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPodLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetPodLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetPodLogsResponse) GetLogs() string {
+	if x != nil {
+		return x.Logs
+	}
+	return ""
+}
+
+type StreamPodLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Follow        bool                   `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
+	Timestamps    bool                   `protobuf:"varint,5,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPodLogsRequest) Reset() {
+	*x = StreamPodLogsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPodLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPodLogsRequest) ProtoMessage() {}
+
+func (x *StreamPodLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPodLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPodLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StreamPodLogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamPodLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StreamPodLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *StreamPodLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamPodLogsRequest) GetTimestamps() bool {
+	if x != nil {
+		return x.Timestamps
+	}
+	return false
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LogEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ExecPodCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Command       []string               `protobuf:"bytes,4,rep,name=command,proto3" json:"command,omitempty"`
+	Stdin         bool                   `protobuf:"varint,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Stdout        bool                   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        bool                   `protobuf:"varint,7,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Tty           bool                   `protobuf:"varint,8,opt,name=tty,proto3" json:"tty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecPodCommandRequest) Reset() {
+	*x = ExecPodCommandRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecPodCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecPodCommandRequest) ProtoMessage() {}
+
+func (x *ExecPodCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecPodCommandRequest.ProtoReflect.Descriptor instead.
+func (*ExecPodCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExecPodCommandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecPodCommandRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExecPodCommandRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *ExecPodCommandRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ExecPodCommandRequest) GetStdin() bool {
+	if x != nil {
+		return x.Stdin
+	}
+	return false
+}
+
+func (x *ExecPodCommandRequest) GetStdout() bool {
+	if x != nil {
+		return x.Stdout
+	}
+	return false
+}
+
+func (x *ExecPodCommandRequest) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+func (x *ExecPodCommandRequest) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+type ExecPodCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stdout        string                 `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        string                 `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecPodCommandResponse) Reset() {
+	*x = ExecPodCommandResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecPodCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecPodCommandResponse) ProtoMessage() {}
+
+func (x *ExecPodCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecPodCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecPodCommandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExecPodCommandResponse) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ExecPodCommandResponse) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *ExecPodCommandResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type ListDeploymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentsRequest) Reset() {
+	*x = ListDeploymentsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsRequest) ProtoMessage() {}
+
+func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListDeploymentsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeploymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeploymentsResponse) Reset() {
+	*x = ListDeploymentsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeploymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsResponse) ProtoMessage() {}
+
+func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListDeploymentsResponse) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+type Deployment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replicas          int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	ReadyReplicas     int32                  `protobuf:"varint,4,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	AvailableReplicas int32                  `protobuf:"varint,5,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Deployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deployment) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Deployment) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *Deployment) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *Deployment) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *Deployment) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Deployment) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+type ListServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListServicesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListServicesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListServicesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesResponse) Reset() {
+	*x = ListServicesResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesResponse) ProtoMessage() {}
+
+func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ClusterIp     string                 `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
+	ExternalIps   []string               `protobuf:"bytes,5,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty"`
+	Ports         []*ServicePort         `protobuf:"bytes,6,rep,name=ports,proto3" json:"ports,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Selector      map[string]string      `protobuf:"bytes,8,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Service) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Service) GetClusterIp() string {
+	if x != nil {
+		return x.ClusterIp
+	}
+	return ""
+}
+
+func (x *Service) GetExternalIps() []string {
+	if x != nil {
+		return x.ExternalIps
+	}
+	return nil
+}
+
+func (x *Service) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Service) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Service) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+type ServicePort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	TargetPort    int32                  `protobuf:"varint,3,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	NodePort      int32                  `protobuf:"varint,5,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePort) ProtoMessage() {}
+
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ServicePort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServicePort) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServicePort) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *ServicePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServicePort) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+// 数据库工具相关消息
+type ExecuteQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Parameters    []*structpb.Struct     `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Timeout       int32                  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteQueryRequest) Reset() {
+	*x = ExecuteQueryRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteQueryRequest) ProtoMessage() {}
+
+func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteQueryRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExecuteQueryRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ExecuteQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ExecuteQueryRequest) GetParameters() []*structpb.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ExecuteQueryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExecuteQueryRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ExecuteQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*QueryRow            `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	RowCount      int32                  `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ExecutionTime float64                `protobuf:"fixed64,4,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	QueryPlan     string                 `protobuf:"bytes,5,opt,name=query_plan,json=queryPlan,proto3" json:"query_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteQueryResponse) Reset() {
+	*x = ExecuteQueryResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteQueryResponse) ProtoMessage() {}
+
+func (x *ExecuteQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteQueryResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExecuteQueryResponse) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExecuteQueryResponse) GetRows() []*QueryRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ExecuteQueryResponse) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *ExecuteQueryResponse) GetExecutionTime() float64 {
+	if x != nil {
+		return x.ExecutionTime
+	}
+	return 0
+}
+
+func (x *ExecuteQueryResponse) GetQueryPlan() string {
+	if x != nil {
+		return x.QueryPlan
+	}
+	return ""
+}
+
+type QueryRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*structpb.Struct     `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRow) Reset() {
+	*x = QueryRow{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRow) ProtoMessage() {}
+
+func (x *QueryRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRow.ProtoReflect.Descriptor instead.
+func (*QueryRow) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *QueryRow) GetValues() []*structpb.Struct {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type TestConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Timeout       int32                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnectionRequest) Reset() {
+	*x = TestConnectionRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionRequest) ProtoMessage() {}
+
+func (x *TestConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionRequest.ProtoReflect.Descriptor instead.
+func (*TestConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TestConnectionRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *TestConnectionRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type TestConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Latency       float64                `protobuf:"fixed64,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnectionResponse) Reset() {
+	*x = TestConnectionResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionResponse) ProtoMessage() {}
+
+func (x *TestConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionResponse.ProtoReflect.Descriptor instead.
+func (*TestConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TestConnectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestConnectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TestConnectionResponse) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *TestConnectionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ListConnectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          DatabaseType           `protobuf:"varint,1,opt,name=type,proto3,enum=ai_sre.mcp.v1.DatabaseType" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectionsRequest) Reset() {
+	*x = ListConnectionsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsRequest) ProtoMessage() {}
+
+func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListConnectionsRequest) GetType() DatabaseType {
+	if x != nil {
+		return x.Type
+	}
+	return DatabaseType_DATABASE_TYPE_UNSPECIFIED
+}
+
+func (x *ListConnectionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListConnectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*DatabaseConnection  `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectionsResponse) Reset() {
+	*x = ListConnectionsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse) ProtoMessage() {}
+
+func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListConnectionsResponse) GetConnections() []*DatabaseConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type DatabaseConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          DatabaseType           `protobuf:"varint,3,opt,name=type,proto3,enum=ai_sre.mcp.v1.DatabaseType" json:"type,omitempty"`
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Database      string                 `protobuf:"bytes,6,opt,name=database,proto3" json:"database,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	LastUsed      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	Stats         *ConnectionStats       `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseConnection) Reset() {
+	*x = DatabaseConnection{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseConnection) ProtoMessage() {}
+
+func (x *DatabaseConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseConnection.ProtoReflect.Descriptor instead.
+func (*DatabaseConnection) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DatabaseConnection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetType() DatabaseType {
+	if x != nil {
+		return x.Type
+	}
+	return DatabaseType_DATABASE_TYPE_UNSPECIFIED
+}
+
+func (x *DatabaseConnection) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DatabaseConnection) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DatabaseConnection) GetLastUsed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsed
+	}
+	return nil
+}
+
+func (x *DatabaseConnection) GetStats() *ConnectionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ConnectionStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalQueries      int64                  `protobuf:"varint,1,opt,name=total_queries,json=totalQueries,proto3" json:"total_queries,omitempty"`
+	AvgQueryTime      float64                `protobuf:"fixed64,2,opt,name=avg_query_time,json=avgQueryTime,proto3" json:"avg_query_time,omitempty"`
+	ActiveConnections int32                  `protobuf:"varint,3,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	MaxConnections    int32                  `protobuf:"varint,4,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConnectionStats) Reset() {
+	*x = ConnectionStats{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStats) ProtoMessage() {}
+
+func (x *ConnectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStats.ProtoReflect.Descriptor instead.
+func (*ConnectionStats) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ConnectionStats) GetTotalQueries() int64 {
+	if x != nil {
+		return x.TotalQueries
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetAvgQueryTime() float64 {
+	if x != nil {
+		return x.AvgQueryTime
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetActiveConnections() int32 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetMaxConnections() int32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+type GetTableSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	SchemaName    string                 `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableSchemaRequest) Reset() {
+	*x = GetTableSchemaRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableSchemaRequest) ProtoMessage() {}
+
+func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetTableSchemaRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *GetTableSchemaRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GetTableSchemaRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+type GetTableSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableName     string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	SchemaName    string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	Columns       []*TableColumn         `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes       []*TableIndex          `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Constraints   []*TableConstraint     `protobuf:"bytes,5,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableSchemaResponse) Reset() {
+	*x = GetTableSchemaResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableSchemaResponse) ProtoMessage() {}
+
+func (x *GetTableSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTableSchemaResponse) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GetTableSchemaResponse) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *GetTableSchemaResponse) GetColumns() []*TableColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *GetTableSchemaResponse) GetIndexes() []*TableIndex {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *GetTableSchemaResponse) GetConstraints() []*TableConstraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type TableColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataType      string                 `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Nullable      bool                   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	PrimaryKey    bool                   `protobuf:"varint,5,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableColumn) Reset() {
+	*x = TableColumn{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableColumn) ProtoMessage() {}
+
+func (x *TableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableColumn.ProtoReflect.Descriptor instead.
+func (*TableColumn) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TableColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableColumn) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *TableColumn) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *TableColumn) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TableColumn) GetPrimaryKey() bool {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return false
+}
+
+func (x *TableColumn) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type TableIndex struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Unique        bool                   `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableIndex) Reset() {
+	*x = TableIndex{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableIndex) ProtoMessage() {}
+
+func (x *TableIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableIndex.ProtoReflect.Descriptor instead.
+func (*TableIndex) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TableIndex) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableIndex) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableIndex) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *TableIndex) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type TableConstraint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Columns          []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	ReferenceTable   string                 `protobuf:"bytes,4,opt,name=reference_table,json=referenceTable,proto3" json:"reference_table,omitempty"`
+	ReferenceColumns []string               `protobuf:"bytes,5,rep,name=reference_columns,json=referenceColumns,proto3" json:"reference_columns,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TableConstraint) Reset() {
+	*x = TableConstraint{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableConstraint) ProtoMessage() {}
+
+func (x *TableConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableConstraint.ProtoReflect.Descriptor instead.
+func (*TableConstraint) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TableConstraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableConstraint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TableConstraint) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableConstraint) GetReferenceTable() string {
+	if x != nil {
+		return x.ReferenceTable
+	}
+	return ""
+}
+
+func (x *TableConstraint) GetReferenceColumns() []string {
+	if x != nil {
+		return x.ReferenceColumns
+	}
+	return nil
+}
+
+type GetDatabaseStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseStatsRequest) Reset() {
+	*x = GetDatabaseStatsRequest{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseStatsRequest) ProtoMessage() {}
+
+func (x *GetDatabaseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetDatabaseStatsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type GetDatabaseStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalSize     int64                  `protobuf:"varint,1,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	TableCount    int32                  `protobuf:"varint,2,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	IndexCount    int32                  `protobuf:"varint,3,opt,name=index_count,json=indexCount,proto3" json:"index_count,omitempty"`
+	TotalRows     int64                  `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TableStats    []*TableStats          `protobuf:"bytes,5,rep,name=table_stats,json=tableStats,proto3" json:"table_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseStatsResponse) Reset() {
+	*x = GetDatabaseStatsResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseStatsResponse) ProtoMessage() {}
+
+func (x *GetDatabaseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDatabaseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetDatabaseStatsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *GetDatabaseStatsResponse) GetTableCount() int32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+func (x *GetDatabaseStatsResponse) GetIndexCount() int32 {
+	if x != nil {
+		return x.IndexCount
+	}
+	return 0
+}
+
+func (x *GetDatabaseStatsResponse) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *GetDatabaseStatsResponse) GetTableStats() []*TableStats {
+	if x != nil {
+		return x.TableStats
+	}
+	return nil
+}
+
+type TableStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	RowCount      int64                  `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	IndexCount    int32                  `protobuf:"varint,4,opt,name=index_count,json=indexCount,proto3" json:"index_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableStats) Reset() {
+	*x = TableStats{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableStats) ProtoMessage() {}
+
+func (x *TableStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableStats.ProtoReflect.Descriptor instead.
+func (*TableStats) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TableStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TableStats) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *TableStats) GetIndexCount() int32 {
+	if x != nil {
+		return x.IndexCount
+	}
+	return 0
+}
+
+// 通用消息定义
+type PaginationInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int32                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	HasPrev       bool                   `protobuf:"varint,6,opt,name=has_prev,json=hasPrev,proto3" json:"has_prev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaginationInfo) Reset() {
+	*x = PaginationInfo{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationInfo) ProtoMessage() {}
+
+func (x *PaginationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationInfo.ProtoReflect.Descriptor instead.
+func (*PaginationInfo) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PaginationInfo) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PaginationInfo) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PaginationInfo) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PaginationInfo) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *PaginationInfo) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *PaginationInfo) GetHasPrev() bool {
+	if x != nil {
+		return x.HasPrev
+	}
+	return false
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Status        HealthStatus                `protobuf:"varint,1,opt,name=status,proto3,enum=ai_sre.mcp.v1.HealthStatus" json:"status,omitempty"`
+	Service       string                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Version       string                      `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Uptime        int64                       `protobuf:"varint,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Components    map[string]*ComponentHealth `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *HealthCheckResponse) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *HealthCheckResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetComponents() map[string]*ComponentHealth {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type ComponentHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        HealthStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=ai_sre.mcp.v1.HealthStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LastCheck     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
+	ResponseTime  float64                `protobuf:"fixed64,4,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentHealth) Reset() {
+	*x = ComponentHealth{}
+	mi := &file_proto_mcp_mcp_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentHealth) ProtoMessage() {}
+
+func (x *ComponentHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mcp_mcp_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentHealth.ProtoReflect.Descriptor instead.
+func (*ComponentHealth) Descriptor() ([]byte, []int) {
+	return file_proto_mcp_mcp_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ComponentHealth) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *ComponentHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ComponentHealth) GetLastCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheck
+	}
+	return nil
+}
+
+func (x *ComponentHealth) GetResponseTime() float64 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+var File_proto_mcp_mcp_proto protoreflect.FileDescriptor
+
+const file_proto_mcp_mcp_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/mcp/mcp.proto\x12\rai_sre.mcp.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa2\x03\n" +
+	"\x04Tool\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x1b.ai_sre.mcp.v1.ToolCategoryR\bcategory\x12D\n" +
+	"\x11parameters_schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x10parametersSchema\x126\n" +
+	"\bexamples\x18\x05 \x03(\v2\x1a.ai_sre.mcp.v1.ToolExampleR\bexamples\x127\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x1b.ai_sre.mcp.v1.ToolMetadataR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbc\x01\n" +
+	"\vToolExample\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x125\n" +
+	"\targuments\x18\x03 \x01(\v2\x17.google.protobuf.StructR\targuments\x12@\n" +
+	"\x0fexpected_result\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x0eexpectedResult\"\xc6\x01\n" +
+	"\fToolMetadata\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vusage_count\x18\x04 \x01(\x05R\n" +
+	"usageCount\x12,\n" +
+	"\x12avg_execution_time\x18\x05 \x01(\x01R\x10avgExecutionTime\x12!\n" +
+	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\"\x89\x01\n" +
+	"\x10ListToolsRequest\x127\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x1b.ai_sre.mcp.v1.ToolCategoryR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"}\n" +
+	"\x11ListToolsResponse\x12)\n" +
+	"\x05tools\x18\x01 \x03(\v2\x13.ai_sre.mcp.v1.ToolR\x05tools\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.ai_sre.mcp.v1.PaginationInfoR\n" +
+	"pagination\"-\n" +
+	"\x0eGetToolRequest\x12\x1b\n" +
+	"\ttool_name\x18\x01 \x01(\tR\btoolName\"\x9b\x01\n" +
+	"\x0fCallToolRequest\x12\x1b\n" +
+	"\ttool_name\x18\x01 \x01(\tR\btoolName\x125\n" +
+	"\targuments\x18\x02 \x01(\v2\x17.google.protobuf.StructR\targuments\x124\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1a.ai_sre.mcp.v1.CallOptionsR\aoptions\"\xee\x01\n" +
+	"\vCallOptions\x12'\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\x05R\x0etimeoutSeconds\x12\x14\n" +
+	"\x05async\x18\x02 \x01(\bR\x05async\x12!\n" +
+	"\fcallback_url\x18\x03 \x01(\tR\vcallbackUrl\x12A\n" +
+	"\aheaders\x18\x04 \x03(\v2'.ai_sre.mcp.v1.CallOptions.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x02\n" +
+	"\x10CallToolResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
+	"\x06result\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06result\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tR\terrorCode\x12=\n" +
+	"\ametrics\x18\x05 \x01(\v2#.ai_sre.mcp.v1.ToolExecutionMetricsR\ametrics\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9e\x01\n" +
+	"\x14ToolExecutionMetrics\x12%\n" +
+	"\x0eexecution_time\x18\x01 \x01(\x01R\rexecutionTime\x12\x1f\n" +
+	"\vmemory_used\x18\x02 \x01(\x03R\n" +
+	"memoryUsed\x12\x19\n" +
+	"\bcpu_time\x18\x03 \x01(\x03R\acpuTime\x12#\n" +
+	"\rnetwork_calls\x18\x04 \x01(\x05R\fnetworkCalls\"\x93\x01\n" +
+	"\x15BatchCallToolsRequest\x124\n" +
+	"\x05calls\x18\x01 \x03(\v2\x1e.ai_sre.mcp.v1.CallToolRequestR\x05calls\x12\x1b\n" +
+	"\tfail_fast\x18\x02 \x01(\bR\bfailFast\x12'\n" +
+	"\x0fmax_concurrency\x18\x03 \x01(\x05R\x0emaxConcurrency\"\x9d\x01\n" +
+	"\x16BatchCallToolsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.ai_sre.mcp.v1.CallToolResponseR\aresults\x12#\n" +
+	"\rsuccess_count\x18\x02 \x01(\x05R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\x03 \x01(\x05R\ffailureCount\"k\n" +
+	"\x15StreamToolCallRequest\x12\x1b\n" +
+	"\ttool_name\x18\x01 \x01(\tR\btoolName\x125\n" +
+	"\targuments\x18\x02 \x01(\v2\x17.google.protobuf.StructR\targuments\"\xc6\x01\n" +
+	"\rToolCallEvent\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .ai_sre.mcp.v1.ToolCallEventTypeR\x04type\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"x\n" +
+	"\x16PrometheusQueryRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x05R\atimeout\"\xc1\x01\n" +
+	"\x1bPrometheusRangeQueryRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\tR\x04step\x12\x18\n" +
+	"\atimeout\x18\x05 \x01(\x05R\atimeout\"\xf8\x01\n" +
+	"\x17PrometheusQueryResponse\x12\x1f\n" +
+	"\vresult_type\x18\x01 \x01(\tR\n" +
+	"resultType\x129\n" +
+	"\aresults\x18\x02 \x03(\v2\x1f.ai_sre.mcp.v1.PrometheusResultR\aresults\x12G\n" +
+	"\x05stats\x18\x03 \x03(\v21.ai_sre.mcp.v1.PrometheusQueryResponse.StatsEntryR\x05stats\x1a8\n" +
+	"\n" +
+	"StatsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x80\x02\n" +
+	"\x10PrometheusResult\x12C\n" +
+	"\x06metric\x18\x01 \x03(\v2+.ai_sre.mcp.v1.PrometheusResult.MetricEntryR\x06metric\x126\n" +
+	"\x06values\x18\x02 \x03(\v2\x1e.ai_sre.mcp.v1.PrometheusValueR\x06values\x124\n" +
+	"\x05value\x18\x03 \x01(\v2\x1e.ai_sre.mcp.v1.PrometheusValueR\x05value\x1a9\n" +
+	"\vMetricEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x0fPrometheusValue\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x01R\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xd0\x01\n" +
+	"\x16CreateDashboardRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12,\n" +
+	"\x06panels\x18\x04 \x03(\v2\x14.ai_sre.mcp.v1.PanelR\x06panels\x12<\n" +
+	"\bsettings\x18\x05 \x01(\v2 .ai_sre.mcp.v1.DashboardSettingsR\bsettings\"\xc8\x01\n" +
+	"\x05Panel\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x121\n" +
+	"\bgrid_pos\x18\x03 \x01(\v2\x16.ai_sre.mcp.v1.GridPosR\agridPos\x12/\n" +
+	"\atargets\x18\x04 \x03(\v2\x15.ai_sre.mcp.v1.TargetR\atargets\x121\n" +
+	"\aoptions\x18\x05 \x01(\v2\x17.google.protobuf.StructR\aoptions\"A\n" +
+	"\aGridPos\x12\f\n" +
+	"\x01h\x18\x01 \x01(\x05R\x01h\x12\f\n" +
+	"\x01w\x18\x02 \x01(\x05R\x01w\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x05R\x01y\"r\n" +
+	"\x06Target\x12\x12\n" +
+	"\x04expr\x18\x01 \x01(\tR\x04expr\x12#\n" +
+	"\rlegend_format\x18\x02 \x01(\tR\flegendFormat\x12\x15\n" +
+	"\x06ref_id\x18\x03 \x01(\tR\x05refId\x12\x18\n" +
+	"\ainstant\x18\x04 \x01(\bR\ainstant\"w\n" +
+	"\x11DashboardSettings\x12\x1b\n" +
+	"\ttime_from\x18\x01 \x01(\tR\btimeFrom\x12\x17\n" +
+	"\atime_to\x18\x02 \x01(\tR\x06timeTo\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\tR\arefresh\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"e\n" +
+	"\x17CreateDashboardResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"W\n" +
+	"\x15ListDashboardsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"R\n" +
+	"\x16ListDashboardsResponse\x128\n" +
+	"\n" +
+	"dashboards\x18\x01 \x03(\v2\x18.ai_sre.mcp.v1.DashboardR\n" +
+	"dashboards\"\xd5\x01\n" +
+	"\tDashboard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x124\n" +
+	"\acreated\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aupdated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\"V\n" +
+	"\x14GetAlertRulesRequest\x12#\n" +
+	"\rdashboard_uid\x18\x01 \x01(\tR\fdashboardUid\x12\x19\n" +
+	"\bpanel_id\x18\x02 \x01(\tR\apanelId\"G\n" +
+	"\x15GetAlertRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.ai_sre.mcp.v1.AlertRuleR\x05rules\"\xaf\x01\n" +
+	"\tAlertRule\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x1c\n" +
+	"\tfrequency\x18\x04 \x01(\tR\tfrequency\x12$\n" +
+	"\rnotifications\x18\x05 \x03(\tR\rnotifications\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\xab\x02\n" +
+	"\x17ListEC2InstancesRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12!\n" +
+	"\finstance_ids\x18\x02 \x03(\tR\vinstanceIds\x12\x16\n" +
+	"\x06states\x18\x03 \x03(\tR\x06states\x12D\n" +
+	"\x04tags\x18\x04 \x03(\v20.ai_sre.mcp.v1.ListEC2InstancesRequest.TagsEntryR\x04tags\x12\x1f\n" +
+	"\vmax_results\x18\x05 \x01(\x05R\n" +
+	"maxResults\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x06 \x01(\tR\tnextToken\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x18ListEC2InstancesResponse\x128\n" +
+	"\tinstances\x18\x01 \x03(\v2\x1a.ai_sre.mcp.v1.EC2InstanceR\tinstances\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\xbd\x04\n" +
+	"\vEC2Instance\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12#\n" +
+	"\rinstance_type\x18\x02 \x01(\tR\finstanceType\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1b\n" +
+	"\tpublic_ip\x18\x04 \x01(\tR\bpublicIp\x12\x1d\n" +
+	"\n" +
+	"private_ip\x18\x05 \x01(\tR\tprivateIp\x12\x1d\n" +
+	"\n" +
+	"public_dns\x18\x06 \x01(\tR\tpublicDns\x12\x1f\n" +
+	"\vprivate_dns\x18\a \x01(\tR\n" +
+	"privateDns\x12;\n" +
+	"\vlaunch_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"launchTime\x12+\n" +
+	"\x11availability_zone\x18\t \x01(\tR\x10availabilityZone\x12\x15\n" +
+	"\x06vpc_id\x18\n" +
+	" \x01(\tR\x05vpcId\x12\x1b\n" +
+	"\tsubnet_id\x18\v \x01(\tR\bsubnetId\x128\n" +
+	"\x04tags\x18\f \x03(\v2$.ai_sre.mcp.v1.EC2Instance.TagsEntryR\x04tags\x12E\n" +
+	"\x0fsecurity_groups\x18\r \x03(\v2\x1c.ai_sre.mcp.v1.SecurityGroupR\x0esecurityGroups\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\rSecurityGroup\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\"R\n" +
+	"\x17StartEC2InstanceRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"g\n" +
+	"\x16StopEC2InstanceRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"T\n" +
+	"\x19RestartEC2InstanceRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"\xdb\x01\n" +
+	"\x14EC2OperationResponse\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12#\n" +
+	"\rcurrent_state\x18\x03 \x01(\tR\fcurrentState\x12%\n" +
+	"\x0eprevious_state\x18\x04 \x01(\tR\rpreviousState\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"S\n" +
+	"\x18GetInstanceStatusRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"\xef\x01\n" +
+	"\x16InstanceStatusResponse\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12%\n" +
+	"\x0einstance_state\x18\x02 \x01(\tR\rinstanceState\x12#\n" +
+	"\rsystem_status\x18\x03 \x01(\tR\fsystemStatus\x12'\n" +
+	"\x0finstance_status\x18\x04 \x01(\tR\x0einstanceStatus\x12?\n" +
+	"\rstatus_checks\x18\x05 \x03(\v2\x1a.ai_sre.mcp.v1.StatusCheckR\fstatusChecks\"S\n" +
+	"\vStatusCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\adetails\x18\x03 \x03(\tR\adetails\"}\n" +
+	"\x18ListLoadBalancersRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06marker\x18\x04 \x01(\tR\x06marker\"\x80\x01\n" +
+	"\x19ListLoadBalancersResponse\x12B\n" +
+	"\x0eload_balancers\x18\x01 \x03(\v2\x1b.ai_sre.mcp.v1.LoadBalancerR\rloadBalancers\x12\x1f\n" +
+	"\vnext_marker\x18\x02 \x01(\tR\n" +
+	"nextMarker\"\x96\x02\n" +
+	"\fLoadBalancer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bdns_name\x18\x02 \x01(\tR\adnsName\x12\x16\n" +
+	"\x06scheme\x18\x03 \x01(\tR\x06scheme\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12-\n" +
+	"\x12availability_zones\x18\x06 \x03(\tR\x11availabilityZones\x12'\n" +
+	"\x0fsecurity_groups\x18\a \x03(\tR\x0esecurityGroups\x12=\n" +
+	"\fcreated_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"\xaf\x01\n" +
+	"\x0fListPodsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12%\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tR\rlabelSelector\x12%\n" +
+	"\x0efield_selector\x18\x03 \x01(\tR\rfieldSelector\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcontinue\x18\x05 \x01(\tR\bcontinue\"\x88\x01\n" +
+	"\x10ListPodsResponse\x12&\n" +
+	"\x04pods\x18\x01 \x03(\v2\x12.ai_sre.mcp.v1.PodR\x04pods\x12\x1a\n" +
+	"\bcontinue\x18\x02 \x01(\tR\bcontinue\x120\n" +
+	"\x14remaining_item_count\x18\x03 \x01(\x05R\x12remainingItemCount\"\xc6\x04\n" +
+	"\x03Pod\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x15\n" +
+	"\x06pod_ip\x18\x05 \x01(\tR\x05podIp\x12\x17\n" +
+	"\ahost_ip\x18\x06 \x01(\tR\x06hostIp\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x126\n" +
+	"\x06labels\x18\b \x03(\v2\x1e.ai_sre.mcp.v1.Pod.LabelsEntryR\x06labels\x12E\n" +
+	"\vannotations\x18\t \x03(\v2#.ai_sre.mcp.v1.Pod.AnnotationsEntryR\vannotations\x128\n" +
+	"\n" +
+	"containers\x18\n" +
+	" \x03(\v2\x18.ai_sre.mcp.v1.ContainerR\n" +
+	"containers\x12;\n" +
+	"\n" +
+	"conditions\x18\v \x03(\v2\x1b.ai_sre.mcp.v1.PodConditionR\n" +
+	"conditions\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa5\x01\n" +
+	"\tContainer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x123\n" +
+	"\x05state\x18\x03 \x01(\v2\x1d.ai_sre.mcp.v1.ContainerStateR\x05state\x12\x14\n" +
+	"\x05ready\x18\x04 \x01(\bR\x05ready\x12#\n" +
+	"\rrestart_count\x18\x05 \x01(\x05R\frestartCount\"\x8e\x02\n" +
+	"\x0eContainerState\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.ai_sre.mcp.v1.ContainerStateTypeR\x04type\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1b\n" +
+	"\texit_code\x18\x06 \x01(\x05R\bexitCode\"\xfe\x01\n" +
+	"\fPodCondition\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12B\n" +
+	"\x0flast_probe_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastProbeTime\x12L\n" +
+	"\x14last_transition_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12lastTransitionTime\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"A\n" +
+	"\rGetPodRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"f\n" +
+	"\x16ScaleDeploymentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\x03 \x01(\x05R\breplicas\"\xc8\x01\n" +
+	"\x17ScaleDeploymentResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12)\n" +
+	"\x10current_replicas\x18\x03 \x01(\x05R\x0fcurrentReplicas\x12)\n" +
+	"\x10desired_replicas\x18\x04 \x01(\x05R\x0fdesiredReplicas\x12%\n" +
+	"\x0eready_replicas\x18\x05 \x01(\x05R\rreadyReplicas\"\xf5\x01\n" +
+	"\x11GetPodLogsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x04 \x01(\x05R\ttailLines\x129\n" +
+	"\n" +
+	"since_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tsinceTime\x12\x16\n" +
+	"\x06follow\x18\x06 \x01(\bR\x06follow\x12\x1e\n" +
+	"\n" +
+	"timestamps\x18\a \x01(\bR\n" +
+	"timestamps\"(\n" +
+	"\x12GetPodLogsResponse\x12\x12\n" +
+	"\x04logs\x18\x01 \x01(\tR\x04logs\"\x9e\x01\n" +
+	"\x14StreamPodLogsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x16\n" +
+	"\x06follow\x18\x04 \x01(\bR\x06follow\x12\x1e\n" +
+	"\n" +
+	"timestamps\x18\x05 \x01(\bR\n" +
+	"timestamps\"\x8c\x01\n" +
+	"\bLogEntry\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"\xd9\x01\n" +
+	"\x15ExecPodCommandRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x18\n" +
+	"\acommand\x18\x04 \x03(\tR\acommand\x12\x14\n" +
+	"\x05stdin\x18\x05 \x01(\bR\x05stdin\x12\x16\n" +
+	"\x06stdout\x18\x06 \x01(\bR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\a \x01(\bR\x06stderr\x12\x10\n" +
+	"\x03tty\x18\b \x01(\bR\x03tty\"e\n" +
+	"\x16ExecPodCommandResponse\x12\x16\n" +
+	"\x06stdout\x18\x01 \x01(\tR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\x02 \x01(\tR\x06stderr\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\x05R\bexitCode\"s\n" +
+	"\x16ListDeploymentsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12%\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tR\rlabelSelector\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"V\n" +
+	"\x17ListDeploymentsResponse\x12;\n" +
+	"\vdeployments\x18\x01 \x03(\v2\x19.ai_sre.mcp.v1.DeploymentR\vdeployments\"\xf5\x02\n" +
+	"\n" +
+	"Deployment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\x03 \x01(\x05R\breplicas\x12%\n" +
+	"\x0eready_replicas\x18\x04 \x01(\x05R\rreadyReplicas\x12-\n" +
+	"\x12available_replicas\x18\x05 \x01(\x05R\x11availableReplicas\x12=\n" +
+	"\x06labels\x18\x06 \x03(\v2%.ai_sre.mcp.v1.Deployment.LabelsEntryR\x06labels\x12I\n" +
+	"\x12creation_timestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11creationTimestamp\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
+	"\x13ListServicesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12%\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tR\rlabelSelector\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x14ListServicesResponse\x122\n" +
+	"\bservices\x18\x01 \x03(\v2\x16.ai_sre.mcp.v1.ServiceR\bservices\"\xb9\x03\n" +
+	"\aService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"cluster_ip\x18\x04 \x01(\tR\tclusterIp\x12!\n" +
+	"\fexternal_ips\x18\x05 \x03(\tR\vexternalIps\x120\n" +
+	"\x05ports\x18\x06 \x03(\v2\x1a.ai_sre.mcp.v1.ServicePortR\x05ports\x12:\n" +
+	"\x06labels\x18\a \x03(\v2\".ai_sre.mcp.v1.Service.LabelsEntryR\x06labels\x12@\n" +
+	"\bselector\x18\b \x03(\v2$.ai_sre.mcp.v1.Service.SelectorEntryR\bselector\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\vServicePort\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vtarget_port\x18\x03 \x01(\x05R\n" +
+	"targetPort\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x1b\n" +
+	"\tnode_port\x18\x05 \x01(\x05R\bnodePort\"\xb9\x01\n" +
+	"\x13ExecuteQueryRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x127\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v2\x17.google.protobuf.StructR\n" +
+	"parameters\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x18\n" +
+	"\atimeout\x18\x05 \x01(\x05R\atimeout\"\xc0\x01\n" +
+	"\x14ExecuteQueryResponse\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12+\n" +
+	"\x04rows\x18\x02 \x03(\v2\x17.ai_sre.mcp.v1.QueryRowR\x04rows\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x05R\browCount\x12%\n" +
+	"\x0eexecution_time\x18\x04 \x01(\x01R\rexecutionTime\x12\x1d\n" +
+	"\n" +
+	"query_plan\x18\x05 \x01(\tR\tqueryPlan\";\n" +
+	"\bQueryRow\x12/\n" +
+	"\x06values\x18\x01 \x03(\v2\x17.google.protobuf.StructR\x06values\"V\n" +
+	"\x15TestConnectionRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x05R\atimeout\"\x80\x01\n" +
+	"\x16TestConnectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\alatency\x18\x03 \x01(\x01R\alatency\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"a\n" +
+	"\x16ListConnectionsRequest\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.ai_sre.mcp.v1.DatabaseTypeR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"^\n" +
+	"\x17ListConnectionsResponse\x12C\n" +
+	"\vconnections\x18\x01 \x03(\v2!.ai_sre.mcp.v1.DatabaseConnectionR\vconnections\"\xb4\x02\n" +
+	"\x12DatabaseConnection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1b.ai_sre.mcp.v1.DatabaseTypeR\x04type\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x05 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bdatabase\x18\x06 \x01(\tR\bdatabase\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x127\n" +
+	"\tlast_used\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\blastUsed\x124\n" +
+	"\x05stats\x18\t \x01(\v2\x1e.ai_sre.mcp.v1.ConnectionStatsR\x05stats\"\xb4\x01\n" +
+	"\x0fConnectionStats\x12#\n" +
+	"\rtotal_queries\x18\x01 \x01(\x03R\ftotalQueries\x12$\n" +
+	"\x0eavg_query_time\x18\x02 \x01(\x01R\favgQueryTime\x12-\n" +
+	"\x12active_connections\x18\x03 \x01(\x05R\x11activeConnections\x12'\n" +
+	"\x0fmax_connections\x18\x04 \x01(\x05R\x0emaxConnections\"|\n" +
+	"\x15GetTableSchemaRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12\x1f\n" +
+	"\vschema_name\x18\x03 \x01(\tR\n" +
+	"schemaName\"\x85\x02\n" +
+	"\x16GetTableSchemaResponse\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x124\n" +
+	"\acolumns\x18\x03 \x03(\v2\x1a.ai_sre.mcp.v1.TableColumnR\acolumns\x123\n" +
+	"\aindexes\x18\x04 \x03(\v2\x19.ai_sre.mcp.v1.TableIndexR\aindexes\x12@\n" +
+	"\vconstraints\x18\x05 \x03(\v2\x1e.ai_sre.mcp.v1.TableConstraintR\vconstraints\"\xba\x01\n" +
+	"\vTableColumn\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12\x1a\n" +
+	"\bnullable\x18\x03 \x01(\bR\bnullable\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12\x1f\n" +
+	"\vprimary_key\x18\x05 \x01(\bR\n" +
+	"primaryKey\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\"f\n" +
+	"\n" +
+	"TableIndex\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"\xa9\x01\n" +
+	"\x0fTableConstraint\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12'\n" +
+	"\x0freference_table\x18\x04 \x01(\tR\x0ereferenceTable\x12+\n" +
+	"\x11reference_columns\x18\x05 \x03(\tR\x10referenceColumns\">\n" +
+	"\x17GetDatabaseStatsRequest\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\tR\fconnectionId\"\xd6\x01\n" +
+	"\x18GetDatabaseStatsResponse\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x01 \x01(\x03R\ttotalSize\x12\x1f\n" +
+	"\vtable_count\x18\x02 \x01(\x05R\n" +
+	"tableCount\x12\x1f\n" +
+	"\vindex_count\x18\x03 \x01(\x05R\n" +
+	"indexCount\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x04 \x01(\x03R\ttotalRows\x12:\n" +
+	"\vtable_stats\x18\x05 \x03(\v2\x19.ai_sre.mcp.v1.TableStatsR\n" +
+	"tableStats\"r\n" +
+	"\n" +
+	"TableStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x03R\browCount\x12\x1f\n" +
+	"\vindex_count\x18\x04 \x01(\x05R\n" +
+	"indexCount\"\x9c\x01\n" +
+	"\x0ePaginationInfo\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x05R\x05pages\x12\x19\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\x12\x19\n" +
+	"\bhas_prev\x18\x06 \x01(\bR\ahasPrev\"\xc9\x02\n" +
+	"\x13HealthCheckResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.ai_sre.mcp.v1.HealthStatusR\x06status\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
+	"\x06uptime\x18\x04 \x01(\x03R\x06uptime\x12R\n" +
+	"\n" +
+	"components\x18\x05 \x03(\v22.ai_sre.mcp.v1.HealthCheckResponse.ComponentsEntryR\n" +
+	"components\x1a]\n" +
+	"\x0fComponentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.ai_sre.mcp.v1.ComponentHealthR\x05value:\x028\x01\"\xc0\x01\n" +
+	"\x0fComponentHealth\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.ai_sre.mcp.v1.HealthStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"last_check\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tlastCheck\x12#\n" +
+	"\rresponse_time\x18\x04 \x01(\x01R\fresponseTime*\xd4\x01\n" +
+	"\fToolCategory\x12\x1d\n" +
+	"\x19TOOL_CATEGORY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TOOL_CATEGORY_MONITORING\x10\x01\x12\x17\n" +
+	"\x13TOOL_CATEGORY_CLOUD\x10\x02\x12\x1b\n" +
+	"\x17TOOL_CATEGORY_CONTAINER\x10\x03\x12\x1a\n" +
+	"\x16TOOL_CATEGORY_DATABASE\x10\x04\x12\x19\n" +
+	"\x15TOOL_CATEGORY_NETWORK\x10\x05\x12\x1a\n" +
+	"\x16TOOL_CATEGORY_SECURITY\x10\x06*\xe1\x01\n" +
+	"\x11ToolCallEventType\x12$\n" +
+	" TOOL_CALL_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cTOOL_CALL_EVENT_TYPE_STARTED\x10\x01\x12!\n" +
+	"\x1dTOOL_CALL_EVENT_TYPE_PROGRESS\x10\x02\x12\"\n" +
+	"\x1eTOOL_CALL_EVENT_TYPE_COMPLETED\x10\x03\x12\x1f\n" +
+	"\x1bTOOL_CALL_EVENT_TYPE_FAILED\x10\x04\x12\x1c\n" +
+	"\x18TOOL_CALL_EVENT_TYPE_LOG\x10\x05*\xa3\x01\n" +
+	"\x12ContainerStateType\x12$\n" +
+	" CONTAINER_STATE_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCONTAINER_STATE_TYPE_WAITING\x10\x01\x12 \n" +
+	"\x1cCONTAINER_STATE_TYPE_RUNNING\x10\x02\x12#\n" +
+	"\x1fCONTAINER_STATE_TYPE_TERMINATED\x10\x03*\xb9\x01\n" +
+	"\fDatabaseType\x12\x1d\n" +
+	"\x19DATABASE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DATABASE_TYPE_POSTGRESQL\x10\x01\x12\x17\n" +
+	"\x13DATABASE_TYPE_MYSQL\x10\x02\x12\x19\n" +
+	"\x15DATABASE_TYPE_MONGODB\x10\x03\x12\x17\n" +
+	"\x13DATABASE_TYPE_REDIS\x10\x04\x12\x1f\n" +
+	"\x1bDATABASE_TYPE_ELASTICSEARCH\x10\x05*\x81\x01\n" +
+	"\fHealthStatus\x12\x1d\n" +
+	"\x19HEALTH_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15HEALTH_STATUS_HEALTHY\x10\x01\x12\x1a\n" +
+	"\x16HEALTH_STATUS_DEGRADED\x10\x02\x12\x1b\n" +
+	"\x17HEALTH_STATUS_UNHEALTHY\x10\x032\xee\x03\n" +
+	"\x0eMCPToolService\x12N\n" +
+	"\tListTools\x12\x1f.ai_sre.mcp.v1.ListToolsRequest\x1a .ai_sre.mcp.v1.ListToolsResponse\x12=\n" +
+	"\aGetTool\x12\x1d.ai_sre.mcp.v1.GetToolRequest\x1a\x13.ai_sre.mcp.v1.Tool\x12K\n" +
+	"\bCallTool\x12\x1e.ai_sre.mcp.v1.CallToolRequest\x1a\x1f.ai_sre.mcp.v1.CallToolResponse\x12]\n" +
+	"\x0eBatchCallTools\x12$.ai_sre.mcp.v1.BatchCallToolsRequest\x1a%.ai_sre.mcp.v1.BatchCallToolsResponse\x12I\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\".ai_sre.mcp.v1.HealthCheckResponse\x12V\n" +
+	"\x0eStreamToolCall\x12$.ai_sre.mcp.v1.StreamToolCallRequest\x1a\x1c.ai_sre.mcp.v1.ToolCallEvent0\x012\xfe\x03\n" +
+	"\x11MonitoringService\x12`\n" +
+	"\x0fPrometheusQuery\x12%.ai_sre.mcp.v1.PrometheusQueryRequest\x1a&.ai_sre.mcp.v1.PrometheusQueryResponse\x12j\n" +
+	"\x14PrometheusQueryRange\x12*.ai_sre.mcp.v1.PrometheusRangeQueryRequest\x1a&.ai_sre.mcp.v1.PrometheusQueryResponse\x12`\n" +
+	"\x0fCreateDashboard\x12%.ai_sre.mcp.v1.CreateDashboardRequest\x1a&.ai_sre.mcp.v1.CreateDashboardResponse\x12]\n" +
+	"\x0eListDashboards\x12$.ai_sre.mcp.v1.ListDashboardsRequest\x1a%.ai_sre.mcp.v1.ListDashboardsResponse\x12Z\n" +
+	"\rGetAlertRules\x12#.ai_sre.mcp.v1.GetAlertRulesRequest\x1a$.ai_sre.mcp.v1.GetAlertRulesResponse2\xe5\x04\n" +
+	"\fCloudService\x12c\n" +
+	"\x10ListEC2Instances\x12&.ai_sre.mcp.v1.ListEC2InstancesRequest\x1a'.ai_sre.mcp.v1.ListEC2InstancesResponse\x12_\n" +
+	"\x10StartEC2Instance\x12&.ai_sre.mcp.v1.StartEC2InstanceRequest\x1a#.ai_sre.mcp.v1.EC2OperationResponse\x12]\n" +
+	"\x0fStopEC2Instance\x12%.ai_sre.mcp.v1.StopEC2InstanceRequest\x1a#.ai_sre.mcp.v1.EC2OperationResponse\x12c\n" +
+	"\x12RestartEC2Instance\x12(.ai_sre.mcp.v1.RestartEC2InstanceRequest\x1a#.ai_sre.mcp.v1.EC2OperationResponse\x12c\n" +
+	"\x11GetInstanceStatus\x12'.ai_sre.mcp.v1.GetInstanceStatusRequest\x1a%.ai_sre.mcp.v1.InstanceStatusResponse\x12f\n" +
+	"\x11ListLoadBalancers\x12'.ai_sre.mcp.v1.ListLoadBalancersRequest\x1a(.ai_sre.mcp.v1.ListLoadBalancersResponse2\xbb\x05\n" +
+	"\x10ContainerService\x12K\n" +
+	"\bListPods\x12\x1e.ai_sre.mcp.v1.ListPodsRequest\x1a\x1f.ai_sre.mcp.v1.ListPodsResponse\x12:\n" +
+	"\x06GetPod\x12\x1c.ai_sre.mcp.v1.GetPodRequest\x1a\x12.ai_sre.mcp.v1.Pod\x12`\n" +
+	"\x0fScaleDeployment\x12%.ai_sre.mcp.v1.ScaleDeploymentRequest\x1a&.ai_sre.mcp.v1.ScaleDeploymentResponse\x12Q\n" +
+	"\n" +
+	"GetPodLogs\x12 .ai_sre.mcp.v1.GetPodLogsRequest\x1a!.ai_sre.mcp.v1.GetPodLogsResponse\x12O\n" +
+	"\rStreamPodLogs\x12#.ai_sre.mcp.v1.StreamPodLogsRequest\x1a\x17.ai_sre.mcp.v1.LogEntry0\x01\x12]\n" +
+	"\x0eExecPodCommand\x12$.ai_sre.mcp.v1.ExecPodCommandRequest\x1a%.ai_sre.mcp.v1.ExecPodCommandResponse\x12`\n" +
+	"\x0fListDeployments\x12%.ai_sre.mcp.v1.ListDeploymentsRequest\x1a&.ai_sre.mcp.v1.ListDeploymentsResponse\x12W\n" +
+	"\fListServices\x12\".ai_sre.mcp.v1.ListServicesRequest\x1a#.ai_sre.mcp.v1.ListServicesResponse2\xef\x03\n" +
+	"\x0fDatabaseService\x12W\n" +
+	"\fExecuteQuery\x12\".ai_sre.mcp.v1.ExecuteQueryRequest\x1a#.ai_sre.mcp.v1.ExecuteQueryResponse\x12]\n" +
+	"\x0eTestConnection\x12$.ai_sre.mcp.v1.TestConnectionRequest\x1a%.ai_sre.mcp.v1.TestConnectionResponse\x12`\n" +
+	"\x0fListConnections\x12%.ai_sre.mcp.v1.ListConnectionsRequest\x1a&.ai_sre.mcp.v1.ListConnectionsResponse\x12]\n" +
+	"\x0eGetTableSchema\x12$.ai_sre.mcp.v1.GetTableSchemaRequest\x1a%.ai_sre.mcp.v1.GetTableSchemaResponse\x12c\n" +
+	"\x10GetDatabaseStats\x12&.ai_sre.mcp.v1.GetDatabaseStatsRequest\x1a'.ai_sre.mcp.v1.GetDatabaseStatsResponseB[\n" +
+	"\x18com.yourorg.aisre.mcp.v1B\bMcpProtoP\x01Z3github.com/your-org/ai-sre/specs/proto/mcp/v1;mcpv1b\x06proto3"
+
+var (
+	file_proto_mcp_mcp_proto_rawDescOnce sync.Once
+	file_proto_mcp_mcp_proto_rawDescData []byte
+)
+
+func file_proto_mcp_mcp_proto_rawDescGZIP() []byte {
+	file_proto_mcp_mcp_proto_rawDescOnce.Do(func() {
+		file_proto_mcp_mcp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_mcp_mcp_proto_rawDesc), len(file_proto_mcp_mcp_proto_rawDesc)))
+	})
+	return file_proto_mcp_mcp_proto_rawDescData
+}
+
+var file_proto_mcp_mcp_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_mcp_mcp_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_proto_mcp_mcp_proto_goTypes = []any{
+	(ToolCategory)(0),                   // 0: ai_sre.mcp.v1.ToolCategory
+	(ToolCallEventType)(0),              // 1: ai_sre.mcp.v1.ToolCallEventType
+	(ContainerStateType)(0),             // 2: ai_sre.mcp.v1.ContainerStateType
+	(DatabaseType)(0),                   // 3: ai_sre.mcp.v1.DatabaseType
+	(HealthStatus)(0),                   // 4: ai_sre.mcp.v1.HealthStatus
+	(*Tool)(nil),                        // 5: ai_sre.mcp.v1.Tool
+	(*ToolExample)(nil),                 // 6: ai_sre.mcp.v1.ToolExample
+	(*ToolMetadata)(nil),                // 7: ai_sre.mcp.v1.ToolMetadata
+	(*ListToolsRequest)(nil),            // 8: ai_sre.mcp.v1.ListToolsRequest
+	(*ListToolsResponse)(nil),           // 9: ai_sre.mcp.v1.ListToolsResponse
+	(*GetToolRequest)(nil),              // 10: ai_sre.mcp.v1.GetToolRequest
+	(*CallToolRequest)(nil),             // 11: ai_sre.mcp.v1.CallToolRequest
+	(*CallOptions)(nil),                 // 12: ai_sre.mcp.v1.CallOptions
+	(*CallToolResponse)(nil),            // 13: ai_sre.mcp.v1.CallToolResponse
+	(*ToolExecutionMetrics)(nil),        // 14: ai_sre.mcp.v1.ToolExecutionMetrics
+	(*BatchCallToolsRequest)(nil),       // 15: ai_sre.mcp.v1.BatchCallToolsRequest
+	(*BatchCallToolsResponse)(nil),      // 16: ai_sre.mcp.v1.BatchCallToolsResponse
+	(*StreamToolCallRequest)(nil),       // 17: ai_sre.mcp.v1.StreamToolCallRequest
+	(*ToolCallEvent)(nil),               // 18: ai_sre.mcp.v1.ToolCallEvent
+	(*PrometheusQueryRequest)(nil),      // 19: ai_sre.mcp.v1.PrometheusQueryRequest
+	(*PrometheusRangeQueryRequest)(nil), // 20: ai_sre.mcp.v1.PrometheusRangeQueryRequest
+	(*PrometheusQueryResponse)(nil),     // 21: ai_sre.mcp.v1.PrometheusQueryResponse
+	(*PrometheusResult)(nil),            // 22: ai_sre.mcp.v1.PrometheusResult
+	(*PrometheusValue)(nil),             // 23: ai_sre.mcp.v1.PrometheusValue
+	(*CreateDashboardRequest)(nil),      // 24: ai_sre.mcp.v1.CreateDashboardRequest
+	(*Panel)(nil),                       // 25: ai_sre.mcp.v1.Panel
+	(*GridPos)(nil),                     // 26: ai_sre.mcp.v1.GridPos
+	(*Target)(nil),                      // 27: ai_sre.mcp.v1.Target
+	(*DashboardSettings)(nil),           // 28: ai_sre.mcp.v1.DashboardSettings
+	(*CreateDashboardResponse)(nil),     // 29: ai_sre.mcp.v1.CreateDashboardResponse
+	(*ListDashboardsRequest)(nil),       // 30: ai_sre.mcp.v1.ListDashboardsRequest
+	(*ListDashboardsResponse)(nil),      // 31: ai_sre.mcp.v1.ListDashboardsResponse
+	(*Dashboard)(nil),                   // 32: ai_sre.mcp.v1.Dashboard
+	(*GetAlertRulesRequest)(nil),        // 33: ai_sre.mcp.v1.GetAlertRulesRequest
+	(*GetAlertRulesResponse)(nil),       // 34: ai_sre.mcp.v1.GetAlertRulesResponse
+	(*AlertRule)(nil),                   // 35: ai_sre.mcp.v1.AlertRule
+	(*ListEC2InstancesRequest)(nil),     // 36: ai_sre.mcp.v1.ListEC2InstancesRequest
+	(*ListEC2InstancesResponse)(nil),    // 37: ai_sre.mcp.v1.ListEC2InstancesResponse
+	(*EC2Instance)(nil),                 // 38: ai_sre.mcp.v1.EC2Instance
+	(*SecurityGroup)(nil),               // 39: ai_sre.mcp.v1.SecurityGroup
+	(*StartEC2InstanceRequest)(nil),     // 40: ai_sre.mcp.v1.StartEC2InstanceRequest
+	(*StopEC2InstanceRequest)(nil),      // 41: ai_sre.mcp.v1.StopEC2InstanceRequest
+	(*RestartEC2InstanceRequest)(nil),   // 42: ai_sre.mcp.v1.RestartEC2InstanceRequest
+	(*EC2OperationResponse)(nil),        // 43: ai_sre.mcp.v1.EC2OperationResponse
+	(*GetInstanceStatusRequest)(nil),    // 44: ai_sre.mcp.v1.GetInstanceStatusRequest
+	(*InstanceStatusResponse)(nil),      // 45: ai_sre.mcp.v1.InstanceStatusResponse
+	(*StatusCheck)(nil),                 // 46: ai_sre.mcp.v1.StatusCheck
+	(*ListLoadBalancersRequest)(nil),    // 47: ai_sre.mcp.v1.ListLoadBalancersRequest
+	(*ListLoadBalancersResponse)(nil),   // 48: ai_sre.mcp.v1.ListLoadBalancersResponse
+	(*LoadBalancer)(nil),                // 49: ai_sre.mcp.v1.LoadBalancer
+	(*ListPodsRequest)(nil),             // 50: ai_sre.mcp.v1.ListPodsRequest
+	(*ListPodsResponse)(nil),            // 51: ai_sre.mcp.v1.ListPodsResponse
+	(*Pod)(nil),                         // 52: ai_sre.mcp.v1.Pod
+	(*Container)(nil),                   // 53: ai_sre.mcp.v1.Container
+	(*ContainerState)(nil),              // 54: ai_sre.mcp.v1.ContainerState
+	(*PodCondition)(nil),                // 55: ai_sre.mcp.v1.PodCondition
+	(*GetPodRequest)(nil),               // 56: ai_sre.mcp.v1.GetPodRequest
+	(*ScaleDeploymentRequest)(nil),      // 57: ai_sre.mcp.v1.ScaleDeploymentRequest
+	(*ScaleDeploymentResponse)(nil),     // 58: ai_sre.mcp.v1.ScaleDeploymentResponse
+	(*GetPodLogsRequest)(nil),           // 59: ai_sre.mcp.v1.GetPodLogsRequest
+	(*GetPodLogsResponse)(nil),          // 60: ai_sre.mcp.v1.GetPodLogsResponse
+	(*StreamPodLogsRequest)(nil),        // 61: ai_sre.mcp.v1.StreamPodLogsRequest
+	(*LogEntry)(nil),                    // 62: ai_sre.mcp.v1.LogEntry
+	(*ExecPodCommandRequest)(nil),       // 63: ai_sre.mcp.v1.ExecPodCommandRequest
+	(*ExecPodCommandResponse)(nil),      // 64: ai_sre.mcp.v1.ExecPodCommandResponse
+	(*ListDeploymentsRequest)(nil),      // 65: ai_sre.mcp.v1.ListDeploymentsRequest
+	(*ListDeploymentsResponse)(nil),     // 66: ai_sre.mcp.v1.ListDeploymentsResponse
+	(*Deployment)(nil),                  // 67: ai_sre.mcp.v1.Deployment
+	(*ListServicesRequest)(nil),         // 68: ai_sre.mcp.v1.ListServicesRequest
+	(*ListServicesResponse)(nil),        // 69: ai_sre.mcp.v1.ListServicesResponse
+	(*Service)(nil),                     // 70: ai_sre.mcp.v1.Service
+	(*ServicePort)(nil),                 // 71: ai_sre.mcp.v1.ServicePort
+	(*ExecuteQueryRequest)(nil),         // 72: ai_sre.mcp.v1.ExecuteQueryRequest
+	(*ExecuteQueryResponse)(nil),        // 73: ai_sre.mcp.v1.ExecuteQueryResponse
+	(*QueryRow)(nil),                    // 74: ai_sre.mcp.v1.QueryRow
+	(*TestConnectionRequest)(nil),       // 75: ai_sre.mcp.v1.TestConnectionRequest
+	(*TestConnectionResponse)(nil),      // 76: ai_sre.mcp.v1.TestConnectionResponse
+	(*ListConnectionsRequest)(nil),      // 77: ai_sre.mcp.v1.ListConnectionsRequest
+	(*ListConnectionsResponse)(nil),     // 78: ai_sre.mcp.v1.ListConnectionsResponse
+	(*DatabaseConnection)(nil),          // 79: ai_sre.mcp.v1.DatabaseConnection
+	(*ConnectionStats)(nil),             // 80: ai_sre.mcp.v1.ConnectionStats
+	(*GetTableSchemaRequest)(nil),       // 81: ai_sre.mcp.v1.GetTableSchemaRequest
+	(*GetTableSchemaResponse)(nil),      // 82: ai_sre.mcp.v1.GetTableSchemaResponse
+	(*TableColumn)(nil),                 // 83: ai_sre.mcp.v1.TableColumn
+	(*TableIndex)(nil),                  // 84: ai_sre.mcp.v1.TableIndex
+	(*TableConstraint)(nil),             // 85: ai_sre.mcp.v1.TableConstraint
+	(*GetDatabaseStatsRequest)(nil),     // 86: ai_sre.mcp.v1.GetDatabaseStatsRequest
+	(*GetDatabaseStatsResponse)(nil),    // 87: ai_sre.mcp.v1.GetDatabaseStatsResponse
+	(*TableStats)(nil),                  // 88: ai_sre.mcp.v1.TableStats
+	(*PaginationInfo)(nil),              // 89: ai_sre.mcp.v1.PaginationInfo
+	(*HealthCheckResponse)(nil),         // 90: ai_sre.mcp.v1.HealthCheckResponse
+	(*ComponentHealth)(nil),             // 91: ai_sre.mcp.v1.ComponentHealth
+	nil,                                 // 92: ai_sre.mcp.v1.CallOptions.HeadersEntry
+	nil,                                 // 93: ai_sre.mcp.v1.PrometheusQueryResponse.StatsEntry
+	nil,                                 // 94: ai_sre.mcp.v1.PrometheusResult.MetricEntry
+	nil,                                 // 95: ai_sre.mcp.v1.ListEC2InstancesRequest.TagsEntry
+	nil,                                 // 96: ai_sre.mcp.v1.EC2Instance.TagsEntry
+	nil,                                 // 97: ai_sre.mcp.v1.Pod.LabelsEntry
+	nil,                                 // 98: ai_sre.mcp.v1.Pod.AnnotationsEntry
+	nil,                                 // 99: ai_sre.mcp.v1.Deployment.LabelsEntry
+	nil,                                 // 100: ai_sre.mcp.v1.Service.LabelsEntry
+	nil,                                 // 101: ai_sre.mcp.v1.Service.SelectorEntry
+	nil,                                 // 102: ai_sre.mcp.v1.HealthCheckResponse.ComponentsEntry
+	(*structpb.Struct)(nil),             // 103: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),       // 104: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 105: google.protobuf.Empty
+}
+var file_proto_mcp_mcp_proto_depIdxs = []int32{
+	0,   // 0: ai_sre.mcp.v1.Tool.category:type_name -> ai_sre.mcp.v1.ToolCategory
+	103, // 1: ai_sre.mcp.v1.Tool.parameters_schema:type_name -> google.protobuf.Struct
+	6,   // 2: ai_sre.mcp.v1.Tool.examples:type_name -> ai_sre.mcp.v1.ToolExample
+	7,   // 3: ai_sre.mcp.v1.Tool.metadata:type_name -> ai_sre.mcp.v1.ToolMetadata
+	104, // 4: ai_sre.mcp.v1.Tool.created_at:type_name -> google.protobuf.Timestamp
+	104, // 5: ai_sre.mcp.v1.Tool.updated_at:type_name -> google.protobuf.Timestamp
+	103, // 6: ai_sre.mcp.v1.ToolExample.arguments:type_name -> google.protobuf.Struct
+	103, // 7: ai_sre.mcp.v1.ToolExample.expected_result:type_name -> google.protobuf.Struct
+	0,   // 8: ai_sre.mcp.v1.ListToolsRequest.category:type_name -> ai_sre.mcp.v1.ToolCategory
+	5,   // 9: ai_sre.mcp.v1.ListToolsResponse.tools:type_name -> ai_sre.mcp.v1.Tool
+	89,  // 10: ai_sre.mcp.v1.ListToolsResponse.pagination:type_name -> ai_sre.mcp.v1.PaginationInfo
+	103, // 11: ai_sre.mcp.v1.CallToolRequest.arguments:type_name -> google.protobuf.Struct
+	12,  // 12: ai_sre.mcp.v1.CallToolRequest.options:type_name -> ai_sre.mcp.v1.CallOptions
+	92,  // 13: ai_sre.mcp.v1.CallOptions.headers:type_name -> ai_sre.mcp.v1.CallOptions.HeadersEntry
+	103, // 14: ai_sre.mcp.v1.CallToolResponse.result:type_name -> google.protobuf.Struct
+	14,  // 15: ai_sre.mcp.v1.CallToolResponse.metrics:type_name -> ai_sre.mcp.v1.ToolExecutionMetrics
+	104, // 16: ai_sre.mcp.v1.CallToolResponse.timestamp:type_name -> google.protobuf.Timestamp
+	11,  // 17: ai_sre.mcp.v1.BatchCallToolsRequest.calls:type_name -> ai_sre.mcp.v1.CallToolRequest
+	13,  // 18: ai_sre.mcp.v1.BatchCallToolsResponse.results:type_name -> ai_sre.mcp.v1.CallToolResponse
+	103, // 19: ai_sre.mcp.v1.StreamToolCallRequest.arguments:type_name -> google.protobuf.Struct
+	1,   // 20: ai_sre.mcp.v1.ToolCallEvent.type:type_name -> ai_sre.mcp.v1.ToolCallEventType
+	103, // 21: ai_sre.mcp.v1.ToolCallEvent.data:type_name -> google.protobuf.Struct
+	104, // 22: ai_sre.mcp.v1.ToolCallEvent.timestamp:type_name -> google.protobuf.Timestamp
+	104, // 23: ai_sre.mcp.v1.PrometheusQueryRequest.time:type_name -> google.protobuf.Timestamp
+	104, // 24: ai_sre.mcp.v1.PrometheusRangeQueryRequest.start:type_name -> google.protobuf.Timestamp
+	104, // 25: ai_sre.mcp.v1.PrometheusRangeQueryRequest.end:type_name -> google.protobuf.Timestamp
+	22,  // 26: ai_sre.mcp.v1.PrometheusQueryResponse.results:type_name -> ai_sre.mcp.v1.PrometheusResult
+	93,  // 27: ai_sre.mcp.v1.PrometheusQueryResponse.stats:type_name -> ai_sre.mcp.v1.PrometheusQueryResponse.StatsEntry
+	94,  // 28: ai_sre.mcp.v1.PrometheusResult.metric:type_name -> ai_sre.mcp.v1.PrometheusResult.MetricEntry
+	23,  // 29: ai_sre.mcp.v1.PrometheusResult.values:type_name -> ai_sre.mcp.v1.PrometheusValue
+	23,  // 30: ai_sre.mcp.v1.PrometheusResult.value:type_name -> ai_sre.mcp.v1.PrometheusValue
+	25,  // 31: ai_sre.mcp.v1.CreateDashboardRequest.panels:type_name -> ai_sre.mcp.v1.Panel
+	28,  // 32: ai_sre.mcp.v1.CreateDashboardRequest.settings:type_name -> ai_sre.mcp.v1.DashboardSettings
+	26,  // 33: ai_sre.mcp.v1.Panel.grid_pos:type_name -> ai_sre.mcp.v1.GridPos
+	27,  // 34: ai_sre.mcp.v1.Panel.targets:type_name -> ai_sre.mcp.v1.Target
+	103, // 35: ai_sre.mcp.v1.Panel.options:type_name -> google.protobuf.Struct
+	32,  // 36: ai_sre.mcp.v1.ListDashboardsResponse.dashboards:type_name -> ai_sre.mcp.v1.Dashboard
+	104, // 37: ai_sre.mcp.v1.Dashboard.created:type_name -> google.protobuf.Timestamp
+	104, // 38: ai_sre.mcp.v1.Dashboard.updated:type_name -> google.protobuf.Timestamp
+	35,  // 39: ai_sre.mcp.v1.GetAlertRulesResponse.rules:type_name -> ai_sre.mcp.v1.AlertRule
+	95,  // 40: ai_sre.mcp.v1.ListEC2InstancesRequest.tags:type_name -> ai_sre.mcp.v1.ListEC2InstancesRequest.TagsEntry
+	38,  // 41: ai_sre.mcp.v1.ListEC2InstancesResponse.instances:type_name -> ai_sre.mcp.v1.EC2Instance
+	104, // 42: ai_sre.mcp.v1.EC2Instance.launch_time:type_name -> google.protobuf.Timestamp
+	96,  // 43: ai_sre.mcp.v1.EC2Instance.tags:type_name -> ai_sre.mcp.v1.EC2Instance.TagsEntry
+	39,  // 44: ai_sre.mcp.v1.EC2Instance.security_groups:type_name -> ai_sre.mcp.v1.SecurityGroup
+	104, // 45: ai_sre.mcp.v1.EC2OperationResponse.timestamp:type_name -> google.protobuf.Timestamp
+	46,  // 46: ai_sre.mcp.v1.InstanceStatusResponse.status_checks:type_name -> ai_sre.mcp.v1.StatusCheck
+	49,  // 47: ai_sre.mcp.v1.ListLoadBalancersResponse.load_balancers:type_name -> ai_sre.mcp.v1.LoadBalancer
+	104, // 48: ai_sre.mcp.v1.LoadBalancer.created_time:type_name -> google.protobuf.Timestamp
+	52,  // 49: ai_sre.mcp.v1.ListPodsResponse.pods:type_name -> ai_sre.mcp.v1.Pod
+	104, // 50: ai_sre.mcp.v1.Pod.start_time:type_name -> google.protobuf.Timestamp
+	97,  // 51: ai_sre.mcp.v1.Pod.labels:type_name -> ai_sre.mcp.v1.Pod.LabelsEntry
+	98,  // 52: ai_sre.mcp.v1.Pod.annotations:type_name -> ai_sre.mcp.v1.Pod.AnnotationsEntry
+	53,  // 53: ai_sre.mcp.v1.Pod.containers:type_name -> ai_sre.mcp.v1.Container
+	55,  // 54: ai_sre.mcp.v1.Pod.conditions:type_name -> ai_sre.mcp.v1.PodCondition
+	54,  // 55: ai_sre.mcp.v1.Container.state:type_name -> ai_sre.mcp.v1.ContainerState
+	2,   // 56: ai_sre.mcp.v1.ContainerState.type:type_name -> ai_sre.mcp.v1.ContainerStateType
+	104, // 57: ai_sre.mcp.v1.ContainerState.started_at:type_name -> google.protobuf.Timestamp
+	104, // 58: ai_sre.mcp.v1.ContainerState.finished_at:type_name -> google.protobuf.Timestamp
+	104, // 59: ai_sre.mcp.v1.PodCondition.last_probe_time:type_name -> google.protobuf.Timestamp
+	104, // 60: ai_sre.mcp.v1.PodCondition.last_transition_time:type_name -> google.protobuf.Timestamp
+	104, // 61: ai_sre.mcp.v1.GetPodLogsRequest.since_time:type_name -> google.protobuf.Timestamp
+	104, // 62: ai_sre.mcp.v1.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 63: ai_sre.mcp.v1.ListDeploymentsResponse.deployments:type_name -> ai_sre.mcp.v1.Deployment
+	99,  // 64: ai_sre.mcp.v1.Deployment.labels:type_name -> ai_sre.mcp.v1.Deployment.LabelsEntry
+	104, // 65: ai_sre.mcp.v1.Deployment.creation_timestamp:type_name -> google.protobuf.Timestamp
+	70,  // 66: ai_sre.mcp.v1.ListServicesResponse.services:type_name -> ai_sre.mcp.v1.Service
+	71,  // 67: ai_sre.mcp.v1.Service.ports:type_name -> ai_sre.mcp.v1.ServicePort
+	100, // 68: ai_sre.mcp.v1.Service.labels:type_name -> ai_sre.mcp.v1.Service.LabelsEntry
+	101, // 69: ai_sre.mcp.v1.Service.selector:type_name -> ai_sre.mcp.v1.Service.SelectorEntry
+	103, // 70: ai_sre.mcp.v1.ExecuteQueryRequest.parameters:type_name -> google.protobuf.Struct
+	74,  // 71: ai_sre.mcp.v1.ExecuteQueryResponse.rows:type_name -> ai_sre.mcp.v1.QueryRow
+	103, // 72: ai_sre.mcp.v1.QueryRow.values:type_name -> google.protobuf.Struct
+	3,   // 73: ai_sre.mcp.v1.ListConnectionsRequest.type:type_name -> ai_sre.mcp.v1.DatabaseType
+	79,  // 74: ai_sre.mcp.v1.ListConnectionsResponse.connections:type_name -> ai_sre.mcp.v1.DatabaseConnection
+	3,   // 75: ai_sre.mcp.v1.DatabaseConnection.type:type_name -> ai_sre.mcp.v1.DatabaseType
+	104, // 76: ai_sre.mcp.v1.DatabaseConnection.last_used:type_name -> google.protobuf.Timestamp
+	80,  // 77: ai_sre.mcp.v1.DatabaseConnection.stats:type_name -> ai_sre.mcp.v1.ConnectionStats
+	83,  // 78: ai_sre.mcp.v1.GetTableSchemaResponse.columns:type_name -> ai_sre.mcp.v1.TableColumn
+	84,  // 79: ai_sre.mcp.v1.GetTableSchemaResponse.indexes:type_name -> ai_sre.mcp.v1.TableIndex
+	85,  // 80: ai_sre.mcp.v1.GetTableSchemaResponse.constraints:type_name -> ai_sre.mcp.v1.TableConstraint
+	88,  // 81: ai_sre.mcp.v1.GetDatabaseStatsResponse.table_stats:type_name -> ai_sre.mcp.v1.TableStats
+	4,   // 82: ai_sre.mcp.v1.HealthCheckResponse.status:type_name -> ai_sre.mcp.v1.HealthStatus
+	102, // 83: ai_sre.mcp.v1.HealthCheckResponse.components:type_name -> ai_sre.mcp.v1.HealthCheckResponse.ComponentsEntry
+	4,   // 84: ai_sre.mcp.v1.ComponentHealth.status:type_name -> ai_sre.mcp.v1.HealthStatus
+	104, // 85: ai_sre.mcp.v1.ComponentHealth.last_check:type_name -> google.protobuf.Timestamp
+	91,  // 86: ai_sre.mcp.v1.HealthCheckResponse.ComponentsEntry.value:type_name -> ai_sre.mcp.v1.ComponentHealth
+	8,   // 87: ai_sre.mcp.v1.MCPToolService.ListTools:input_type -> ai_sre.mcp.v1.ListToolsRequest
+	10,  // 88: ai_sre.mcp.v1.MCPToolService.GetTool:input_type -> ai_sre.mcp.v1.GetToolRequest
+	11,  // 89: ai_sre.mcp.v1.MCPToolService.CallTool:input_type -> ai_sre.mcp.v1.CallToolRequest
+	15,  // 90: ai_sre.mcp.v1.MCPToolService.BatchCallTools:input_type -> ai_sre.mcp.v1.BatchCallToolsRequest
+	105, // 91: ai_sre.mcp.v1.MCPToolService.HealthCheck:input_type -> google.protobuf.Empty
+	17,  // 92: ai_sre.mcp.v1.MCPToolService.StreamToolCall:input_type -> ai_sre.mcp.v1.StreamToolCallRequest
+	19,  // 93: ai_sre.mcp.v1.MonitoringService.PrometheusQuery:input_type -> ai_sre.mcp.v1.PrometheusQueryRequest
+	20,  // 94: ai_sre.mcp.v1.MonitoringService.PrometheusQueryRange:input_type -> ai_sre.mcp.v1.PrometheusRangeQueryRequest
+	24,  // 95: ai_sre.mcp.v1.MonitoringService.CreateDashboard:input_type -> ai_sre.mcp.v1.CreateDashboardRequest
+	30,  // 96: ai_sre.mcp.v1.MonitoringService.ListDashboards:input_type -> ai_sre.mcp.v1.ListDashboardsRequest
+	33,  // 97: ai_sre.mcp.v1.MonitoringService.GetAlertRules:input_type -> ai_sre.mcp.v1.GetAlertRulesRequest
+	36,  // 98: ai_sre.mcp.v1.CloudService.ListEC2Instances:input_type -> ai_sre.mcp.v1.ListEC2InstancesRequest
+	40,  // 99: ai_sre.mcp.v1.CloudService.StartEC2Instance:input_type -> ai_sre.mcp.v1.StartEC2InstanceRequest
+	41,  // 100: ai_sre.mcp.v1.CloudService.StopEC2Instance:input_type -> ai_sre.mcp.v1.StopEC2InstanceRequest
+	42,  // 101: ai_sre.mcp.v1.CloudService.RestartEC2Instance:input_type -> ai_sre.mcp.v1.RestartEC2InstanceRequest
+	44,  // 102: ai_sre.mcp.v1.CloudService.GetInstanceStatus:input_type -> ai_sre.mcp.v1.GetInstanceStatusRequest
+	47,  // 103: ai_sre.mcp.v1.CloudService.ListLoadBalancers:input_type -> ai_sre.mcp.v1.ListLoadBalancersRequest
+	50,  // 104: ai_sre.mcp.v1.ContainerService.ListPods:input_type -> ai_sre.mcp.v1.ListPodsRequest
+	56,  // 105: ai_sre.mcp.v1.ContainerService.GetPod:input_type -> ai_sre.mcp.v1.GetPodRequest
+	57,  // 106: ai_sre.mcp.v1.ContainerService.ScaleDeployment:input_type -> ai_sre.mcp.v1.ScaleDeploymentRequest
+	59,  // 107: ai_sre.mcp.v1.ContainerService.GetPodLogs:input_type -> ai_sre.mcp.v1.GetPodLogsRequest
+	61,  // 108: ai_sre.mcp.v1.ContainerService.StreamPodLogs:input_type -> ai_sre.mcp.v1.StreamPodLogsRequest
+	63,  // 109: ai_sre.mcp.v1.ContainerService.ExecPodCommand:input_type -> ai_sre.mcp.v1.ExecPodCommandRequest
+	65,  // 110: ai_sre.mcp.v1.ContainerService.ListDeployments:input_type -> ai_sre.mcp.v1.ListDeploymentsRequest
+	68,  // 111: ai_sre.mcp.v1.ContainerService.ListServices:input_type -> ai_sre.mcp.v1.ListServicesRequest
+	72,  // 112: ai_sre.mcp.v1.DatabaseService.ExecuteQuery:input_type -> ai_sre.mcp.v1.ExecuteQueryRequest
+	75,  // 113: ai_sre.mcp.v1.DatabaseService.TestConnection:input_type -> ai_sre.mcp.v1.TestConnectionRequest
+	77,  // 114: ai_sre.mcp.v1.DatabaseService.ListConnections:input_type -> ai_sre.mcp.v1.ListConnectionsRequest
+	81,  // 115: ai_sre.mcp.v1.DatabaseService.GetTableSchema:input_type -> ai_sre.mcp.v1.GetTableSchemaRequest
+	86,  // 116: ai_sre.mcp.v1.DatabaseService.GetDatabaseStats:input_type -> ai_sre.mcp.v1.GetDatabaseStatsRequest
+	9,   // 117: ai_sre.mcp.v1.MCPToolService.ListTools:output_type -> ai_sre.mcp.v1.ListToolsResponse
+	5,   // 118: ai_sre.mcp.v1.MCPToolService.GetTool:output_type -> ai_sre.mcp.v1.Tool
+	13,  // 119: ai_sre.mcp.v1.MCPToolService.CallTool:output_type -> ai_sre.mcp.v1.CallToolResponse
+	16,  // 120: ai_sre.mcp.v1.MCPToolService.BatchCallTools:output_type -> ai_sre.mcp.v1.BatchCallToolsResponse
+	90,  // 121: ai_sre.mcp.v1.MCPToolService.HealthCheck:output_type -> ai_sre.mcp.v1.HealthCheckResponse
+	18,  // 122: ai_sre.mcp.v1.MCPToolService.StreamToolCall:output_type -> ai_sre.mcp.v1.ToolCallEvent
+	21,  // 123: ai_sre.mcp.v1.MonitoringService.PrometheusQuery:output_type -> ai_sre.mcp.v1.PrometheusQueryResponse
+	21,  // 124: ai_sre.mcp.v1.MonitoringService.PrometheusQueryRange:output_type -> ai_sre.mcp.v1.PrometheusQueryResponse
+	29,  // 125: ai_sre.mcp.v1.MonitoringService.CreateDashboard:output_type -> ai_sre.mcp.v1.CreateDashboardResponse
+	31,  // 126: ai_sre.mcp.v1.MonitoringService.ListDashboards:output_type -> ai_sre.mcp.v1.ListDashboardsResponse
+	34,  // 127: ai_sre.mcp.v1.MonitoringService.GetAlertRules:output_type -> ai_sre.mcp.v1.GetAlertRulesResponse
+	37,  // 128: ai_sre.mcp.v1.CloudService.ListEC2Instances:output_type -> ai_sre.mcp.v1.ListEC2InstancesResponse
+	43,  // 129: ai_sre.mcp.v1.CloudService.StartEC2Instance:output_type -> ai_sre.mcp.v1.EC2OperationResponse
+	43,  // 130: ai_sre.mcp.v1.CloudService.StopEC2Instance:output_type -> ai_sre.mcp.v1.EC2OperationResponse
+	43,  // 131: ai_sre.mcp.v1.CloudService.RestartEC2Instance:output_type -> ai_sre.mcp.v1.EC2OperationResponse
+	45,  // 132: ai_sre.mcp.v1.CloudService.GetInstanceStatus:output_type -> ai_sre.mcp.v1.InstanceStatusResponse
+	48,  // 133: ai_sre.mcp.v1.CloudService.ListLoadBalancers:output_type -> ai_sre.mcp.v1.ListLoadBalancersResponse
+	51,  // 134: ai_sre.mcp.v1.ContainerService.ListPods:output_type -> ai_sre.mcp.v1.ListPodsResponse
+	52,  // 135: ai_sre.mcp.v1.ContainerService.GetPod:output_type -> ai_sre.mcp.v1.Pod
+	58,  // 136: ai_sre.mcp.v1.ContainerService.ScaleDeployment:output_type -> ai_sre.mcp.v1.ScaleDeploymentResponse
+	60,  // 137: ai_sre.mcp.v1.ContainerService.GetPodLogs:output_type -> ai_sre.mcp.v1.GetPodLogsResponse
+	62,  // 138: ai_sre.mcp.v1.ContainerService.StreamPodLogs:output_type -> ai_sre.mcp.v1.LogEntry
+	64,  // 139: ai_sre.mcp.v1.ContainerService.ExecPodCommand:output_type -> ai_sre.mcp.v1.ExecPodCommandResponse
+	66,  // 140: ai_sre.mcp.v1.ContainerService.ListDeployments:output_type -> ai_sre.mcp.v1.ListDeploymentsResponse
+	69,  // 141: ai_sre.mcp.v1.ContainerService.ListServices:output_type -> ai_sre.mcp.v1.ListServicesResponse
+	73,  // 142: ai_sre.mcp.v1.DatabaseService.ExecuteQuery:output_type -> ai_sre.mcp.v1.ExecuteQueryResponse
+	76,  // 143: ai_sre.mcp.v1.DatabaseService.TestConnection:output_type -> ai_sre.mcp.v1.TestConnectionResponse
+	78,  // 144: ai_sre.mcp.v1.DatabaseService.ListConnections:output_type -> ai_sre.mcp.v1.ListConnectionsResponse
+	82,  // 145: ai_sre.mcp.v1.DatabaseService.GetTableSchema:output_type -> ai_sre.mcp.v1.GetTableSchemaResponse
+	87,  // 146: ai_sre.mcp.v1.DatabaseService.GetDatabaseStats:output_type -> ai_sre.mcp.v1.GetDatabaseStatsResponse
+	117, // [117:147] is the sub-list for method output_type
+	87,  // [87:117] is the sub-list for method input_type
+	87,  // [87:87] is the sub-list for extension type_name
+	87,  // [87:87] is the sub-list for extension extendee
+	0,   // [0:87] is the sub-list for field type_name
+}
+
+func init() { file_proto_mcp_mcp_proto_init() }
+func file_proto_mcp_mcp_proto_init() {
+	if File_proto_mcp_mcp_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mcp_mcp_proto_rawDesc), len(file_proto_mcp_mcp_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   98,
+			NumExtensions: 0,
+			NumServices:   5,
+		},
+		GoTypes:           file_proto_mcp_mcp_proto_goTypes,
+		DependencyIndexes: file_proto_mcp_mcp_proto_depIdxs,
+		EnumInfos:         file_proto_mcp_mcp_proto_enumTypes,
+		MessageInfos:      file_proto_mcp_mcp_proto_msgTypes,
+	}.Build()
+	File_proto_mcp_mcp_proto = out.File
+	file_proto_mcp_mcp_proto_goTypes = nil
+	file_proto_mcp_mcp_proto_depIdxs = nil
+}
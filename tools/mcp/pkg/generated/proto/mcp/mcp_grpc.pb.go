@@ -0,0 +1,1558 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/mcp/mcp.proto
+
+package mcpv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MCPToolService_ListTools_FullMethodName      = "/ai_sre.mcp.v1.MCPToolService/ListTools"
+	MCPToolService_GetTool_FullMethodName        = "/ai_sre.mcp.v1.MCPToolService/GetTool"
+	MCPToolService_CallTool_FullMethodName       = "/ai_sre.mcp.v1.MCPToolService/CallTool"
+	MCPToolService_BatchCallTools_FullMethodName = "/ai_sre.mcp.v1.MCPToolService/BatchCallTools"
+	MCPToolService_HealthCheck_FullMethodName    = "/ai_sre.mcp.v1.MCPToolService/HealthCheck"
+	MCPToolService_StreamToolCall_FullMethodName = "/ai_sre.mcp.v1.MCPToolService/StreamToolCall"
+)
+
+// MCPToolServiceClient is the client API for MCPToolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MCP工具服务定义
+type MCPToolServiceClient interface {
+	// 获取可用工具列表
+	ListTools(ctx context.Context, in *ListToolsRequest, opts ...grpc.CallOption) (*ListToolsResponse, error)
+	// 获取工具详情
+	GetTool(ctx context.Context, in *GetToolRequest, opts ...grpc.CallOption) (*Tool, error)
+	// 调用工具
+	CallTool(ctx context.Context, in *CallToolRequest, opts ...grpc.CallOption) (*CallToolResponse, error)
+	// 批量调用工具
+	BatchCallTools(ctx context.Context, in *BatchCallToolsRequest, opts ...grpc.CallOption) (*BatchCallToolsResponse, error)
+	// 工具健康检查
+	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// 工具调用流
+	StreamToolCall(ctx context.Context, in *StreamToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ToolCallEvent], error)
+}
+
+type mCPToolServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMCPToolServiceClient(cc grpc.ClientConnInterface) MCPToolServiceClient {
+	return &mCPToolServiceClient{cc}
+}
+
+func (c *mCPToolServiceClient) ListTools(ctx context.Context, in *ListToolsRequest, opts ...grpc.CallOption) (*ListToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListToolsResponse)
+	err := c.cc.Invoke(ctx, MCPToolService_ListTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPToolServiceClient) GetTool(ctx context.Context, in *GetToolRequest, opts ...grpc.CallOption) (*Tool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tool)
+	err := c.cc.Invoke(ctx, MCPToolService_GetTool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPToolServiceClient) CallTool(ctx context.Context, in *CallToolRequest, opts ...grpc.CallOption) (*CallToolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallToolResponse)
+	err := c.cc.Invoke(ctx, MCPToolService_CallTool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPToolServiceClient) BatchCallTools(ctx context.Context, in *BatchCallToolsRequest, opts ...grpc.CallOption) (*BatchCallToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCallToolsResponse)
+	err := c.cc.Invoke(ctx, MCPToolService_BatchCallTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPToolServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, MCPToolService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCPToolServiceClient) StreamToolCall(ctx context.Context, in *StreamToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ToolCallEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MCPToolService_ServiceDesc.Streams[0], MCPToolService_StreamToolCall_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamToolCallRequest, ToolCallEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MCPToolService_StreamToolCallClient = grpc.ServerStreamingClient[ToolCallEvent]
+
+// MCPToolServiceServer is the server API for MCPToolService service.
+// All implementations should embed UnimplementedMCPToolServiceServer
+// for forward compatibility.
+//
+// MCP工具服务定义
+type MCPToolServiceServer interface {
+	// 获取可用工具列表
+	ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error)
+	// 获取工具详情
+	GetTool(context.Context, *GetToolRequest) (*Tool, error)
+	// 调用工具
+	CallTool(context.Context, *CallToolRequest) (*CallToolResponse, error)
+	// 批量调用工具
+	BatchCallTools(context.Context, *BatchCallToolsRequest) (*BatchCallToolsResponse, error)
+	// 工具健康检查
+	HealthCheck(context.Context, *emptypb.Empty) (*HealthCheckResponse, error)
+	// 工具调用流
+	StreamToolCall(*StreamToolCallRequest, grpc.ServerStreamingServer[ToolCallEvent]) error
+}
+
+// UnimplementedMCPToolServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMCPToolServiceServer struct{}
+
+func (UnimplementedMCPToolServiceServer) ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTools not implemented")
+}
+func (UnimplementedMCPToolServiceServer) GetTool(context.Context, *GetToolRequest) (*Tool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTool not implemented")
+}
+func (UnimplementedMCPToolServiceServer) CallTool(context.Context, *CallToolRequest) (*CallToolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallTool not implemented")
+}
+func (UnimplementedMCPToolServiceServer) BatchCallTools(context.Context, *BatchCallToolsRequest) (*BatchCallToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCallTools not implemented")
+}
+func (UnimplementedMCPToolServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedMCPToolServiceServer) StreamToolCall(*StreamToolCallRequest, grpc.ServerStreamingServer[ToolCallEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamToolCall not implemented")
+}
+func (UnimplementedMCPToolServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMCPToolServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MCPToolServiceServer will
+// result in compilation errors.
+type UnsafeMCPToolServiceServer interface {
+	mustEmbedUnimplementedMCPToolServiceServer()
+}
+
+func RegisterMCPToolServiceServer(s grpc.ServiceRegistrar, srv MCPToolServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMCPToolServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MCPToolService_ServiceDesc, srv)
+}
+
+func _MCPToolService_ListTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPToolServiceServer).ListTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPToolService_ListTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPToolServiceServer).ListTools(ctx, req.(*ListToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPToolService_GetTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPToolServiceServer).GetTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPToolService_GetTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPToolServiceServer).GetTool(ctx, req.(*GetToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPToolService_CallTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPToolServiceServer).CallTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPToolService_CallTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPToolServiceServer).CallTool(ctx, req.(*CallToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPToolService_BatchCallTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCallToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPToolServiceServer).BatchCallTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPToolService_BatchCallTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPToolServiceServer).BatchCallTools(ctx, req.(*BatchCallToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPToolService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPToolServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPToolService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPToolServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCPToolService_StreamToolCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamToolCallRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MCPToolServiceServer).StreamToolCall(m, &grpc.GenericServerStream[StreamToolCallRequest, ToolCallEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MCPToolService_StreamToolCallServer = grpc.ServerStreamingServer[ToolCallEvent]
+
+// MCPToolService_ServiceDesc is the grpc.ServiceDesc for MCPToolService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MCPToolService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai_sre.mcp.v1.MCPToolService",
+	HandlerType: (*MCPToolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTools",
+			Handler:    _MCPToolService_ListTools_Handler,
+		},
+		{
+			MethodName: "GetTool",
+			Handler:    _MCPToolService_GetTool_Handler,
+		},
+		{
+			MethodName: "CallTool",
+			Handler:    _MCPToolService_CallTool_Handler,
+		},
+		{
+			MethodName: "BatchCallTools",
+			Handler:    _MCPToolService_BatchCallTools_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _MCPToolService_HealthCheck_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamToolCall",
+			Handler:       _MCPToolService_StreamToolCall_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/mcp/mcp.proto",
+}
+
+const (
+	MonitoringService_PrometheusQuery_FullMethodName      = "/ai_sre.mcp.v1.MonitoringService/PrometheusQuery"
+	MonitoringService_PrometheusQueryRange_FullMethodName = "/ai_sre.mcp.v1.MonitoringService/PrometheusQueryRange"
+	MonitoringService_CreateDashboard_FullMethodName      = "/ai_sre.mcp.v1.MonitoringService/CreateDashboard"
+	MonitoringService_ListDashboards_FullMethodName       = "/ai_sre.mcp.v1.MonitoringService/ListDashboards"
+	MonitoringService_GetAlertRules_FullMethodName        = "/ai_sre.mcp.v1.MonitoringService/GetAlertRules"
+)
+
+// MonitoringServiceClient is the client API for MonitoringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 监控工具服务定义
+type MonitoringServiceClient interface {
+	// Prometheus查询
+	PrometheusQuery(ctx context.Context, in *PrometheusQueryRequest, opts ...grpc.CallOption) (*PrometheusQueryResponse, error)
+	// Prometheus范围查询
+	PrometheusQueryRange(ctx context.Context, in *PrometheusRangeQueryRequest, opts ...grpc.CallOption) (*PrometheusQueryResponse, error)
+	// 创建Grafana仪表板
+	CreateDashboard(ctx context.Context, in *CreateDashboardRequest, opts ...grpc.CallOption) (*CreateDashboardResponse, error)
+	// 获取仪表板列表
+	ListDashboards(ctx context.Context, in *ListDashboardsRequest, opts ...grpc.CallOption) (*ListDashboardsResponse, error)
+	// 获取告警规则
+	GetAlertRules(ctx context.Context, in *GetAlertRulesRequest, opts ...grpc.CallOption) (*GetAlertRulesResponse, error)
+}
+
+type monitoringServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMonitoringServiceClient(cc grpc.ClientConnInterface) MonitoringServiceClient {
+	return &monitoringServiceClient{cc}
+}
+
+func (c *monitoringServiceClient) PrometheusQuery(ctx context.Context, in *PrometheusQueryRequest, opts ...grpc.CallOption) (*PrometheusQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrometheusQueryResponse)
+	err := c.cc.Invoke(ctx, MonitoringService_PrometheusQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitoringServiceClient) PrometheusQueryRange(ctx context.Context, in *PrometheusRangeQueryRequest, opts ...grpc.CallOption) (*PrometheusQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrometheusQueryResponse)
+	err := c.cc.Invoke(ctx, MonitoringService_PrometheusQueryRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitoringServiceClient) CreateDashboard(ctx context.Context, in *CreateDashboardRequest, opts ...grpc.CallOption) (*CreateDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDashboardResponse)
+	err := c.cc.Invoke(ctx, MonitoringService_CreateDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitoringServiceClient) ListDashboards(ctx context.Context, in *ListDashboardsRequest, opts ...grpc.CallOption) (*ListDashboardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDashboardsResponse)
+	err := c.cc.Invoke(ctx, MonitoringService_ListDashboards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitoringServiceClient) GetAlertRules(ctx context.Context, in *GetAlertRulesRequest, opts ...grpc.CallOption) (*GetAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertRulesResponse)
+	err := c.cc.Invoke(ctx, MonitoringService_GetAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MonitoringServiceServer is the server API for MonitoringService service.
+// All implementations should embed UnimplementedMonitoringServiceServer
+// for forward compatibility.
+//
+// 监控工具服务定义
+type MonitoringServiceServer interface {
+	// Prometheus查询
+	PrometheusQuery(context.Context, *PrometheusQueryRequest) (*PrometheusQueryResponse, error)
+	// Prometheus范围查询
+	PrometheusQueryRange(context.Context, *PrometheusRangeQueryRequest) (*PrometheusQueryResponse, error)
+	// 创建Grafana仪表板
+	CreateDashboard(context.Context, *CreateDashboardRequest) (*CreateDashboardResponse, error)
+	// 获取仪表板列表
+	ListDashboards(context.Context, *ListDashboardsRequest) (*ListDashboardsResponse, error)
+	// 获取告警规则
+	GetAlertRules(context.Context, *GetAlertRulesRequest) (*GetAlertRulesResponse, error)
+}
+
+// UnimplementedMonitoringServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMonitoringServiceServer struct{}
+
+func (UnimplementedMonitoringServiceServer) PrometheusQuery(context.Context, *PrometheusQueryRequest) (*PrometheusQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrometheusQuery not implemented")
+}
+func (UnimplementedMonitoringServiceServer) PrometheusQueryRange(context.Context, *PrometheusRangeQueryRequest) (*PrometheusQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrometheusQueryRange not implemented")
+}
+func (UnimplementedMonitoringServiceServer) CreateDashboard(context.Context, *CreateDashboardRequest) (*CreateDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDashboard not implemented")
+}
+func (UnimplementedMonitoringServiceServer) ListDashboards(context.Context, *ListDashboardsRequest) (*ListDashboardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDashboards not implemented")
+}
+func (UnimplementedMonitoringServiceServer) GetAlertRules(context.Context, *GetAlertRulesRequest) (*GetAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRules not implemented")
+}
+func (UnimplementedMonitoringServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMonitoringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MonitoringServiceServer will
+// result in compilation errors.
+type UnsafeMonitoringServiceServer interface {
+	mustEmbedUnimplementedMonitoringServiceServer()
+}
+
+func RegisterMonitoringServiceServer(s grpc.ServiceRegistrar, srv MonitoringServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMonitoringServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MonitoringService_ServiceDesc, srv)
+}
+
+func _MonitoringService_PrometheusQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrometheusQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitoringServiceServer).PrometheusQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MonitoringService_PrometheusQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitoringServiceServer).PrometheusQuery(ctx, req.(*PrometheusQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MonitoringService_PrometheusQueryRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrometheusRangeQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitoringServiceServer).PrometheusQueryRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MonitoringService_PrometheusQueryRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitoringServiceServer).PrometheusQueryRange(ctx, req.(*PrometheusRangeQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MonitoringService_CreateDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitoringServiceServer).CreateDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MonitoringService_CreateDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitoringServiceServer).CreateDashboard(ctx, req.(*CreateDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MonitoringService_ListDashboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDashboardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitoringServiceServer).ListDashboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MonitoringService_ListDashboards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitoringServiceServer).ListDashboards(ctx, req.(*ListDashboardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MonitoringService_GetAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitoringServiceServer).GetAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MonitoringService_GetAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitoringServiceServer).GetAlertRules(ctx, req.(*GetAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MonitoringService_ServiceDesc is the grpc.ServiceDesc for MonitoringService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MonitoringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai_sre.mcp.v1.MonitoringService",
+	HandlerType: (*MonitoringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PrometheusQuery",
+			Handler:    _MonitoringService_PrometheusQuery_Handler,
+		},
+		{
+			MethodName: "PrometheusQueryRange",
+			Handler:    _MonitoringService_PrometheusQueryRange_Handler,
+		},
+		{
+			MethodName: "CreateDashboard",
+			Handler:    _MonitoringService_CreateDashboard_Handler,
+		},
+		{
+			MethodName: "ListDashboards",
+			Handler:    _MonitoringService_ListDashboards_Handler,
+		},
+		{
+			MethodName: "GetAlertRules",
+			Handler:    _MonitoringService_GetAlertRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/mcp/mcp.proto",
+}
+
+const (
+	CloudService_ListEC2Instances_FullMethodName   = "/ai_sre.mcp.v1.CloudService/ListEC2Instances"
+	CloudService_StartEC2Instance_FullMethodName   = "/ai_sre.mcp.v1.CloudService/StartEC2Instance"
+	CloudService_StopEC2Instance_FullMethodName    = "/ai_sre.mcp.v1.CloudService/StopEC2Instance"
+	CloudService_RestartEC2Instance_FullMethodName = "/ai_sre.mcp.v1.CloudService/RestartEC2Instance"
+	CloudService_GetInstanceStatus_FullMethodName  = "/ai_sre.mcp.v1.CloudService/GetInstanceStatus"
+	CloudService_ListLoadBalancers_FullMethodName  = "/ai_sre.mcp.v1.CloudService/ListLoadBalancers"
+)
+
+// CloudServiceClient is the client API for CloudService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 云平台工具服务定义
+type CloudServiceClient interface {
+	// 列出EC2实例
+	ListEC2Instances(ctx context.Context, in *ListEC2InstancesRequest, opts ...grpc.CallOption) (*ListEC2InstancesResponse, error)
+	// 启动EC2实例
+	StartEC2Instance(ctx context.Context, in *StartEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error)
+	// 停止EC2实例
+	StopEC2Instance(ctx context.Context, in *StopEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error)
+	// 重启EC2实例
+	RestartEC2Instance(ctx context.Context, in *RestartEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error)
+	// 获取实例状态
+	GetInstanceStatus(ctx context.Context, in *GetInstanceStatusRequest, opts ...grpc.CallOption) (*InstanceStatusResponse, error)
+	// 列出负载均衡器
+	ListLoadBalancers(ctx context.Context, in *ListLoadBalancersRequest, opts ...grpc.CallOption) (*ListLoadBalancersResponse, error)
+}
+
+type cloudServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCloudServiceClient(cc grpc.ClientConnInterface) CloudServiceClient {
+	return &cloudServiceClient{cc}
+}
+
+func (c *cloudServiceClient) ListEC2Instances(ctx context.Context, in *ListEC2InstancesRequest, opts ...grpc.CallOption) (*ListEC2InstancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEC2InstancesResponse)
+	err := c.cc.Invoke(ctx, CloudService_ListEC2Instances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) StartEC2Instance(ctx context.Context, in *StartEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EC2OperationResponse)
+	err := c.cc.Invoke(ctx, CloudService_StartEC2Instance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) StopEC2Instance(ctx context.Context, in *StopEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EC2OperationResponse)
+	err := c.cc.Invoke(ctx, CloudService_StopEC2Instance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) RestartEC2Instance(ctx context.Context, in *RestartEC2InstanceRequest, opts ...grpc.CallOption) (*EC2OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EC2OperationResponse)
+	err := c.cc.Invoke(ctx, CloudService_RestartEC2Instance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) GetInstanceStatus(ctx context.Context, in *GetInstanceStatusRequest, opts ...grpc.CallOption) (*InstanceStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceStatusResponse)
+	err := c.cc.Invoke(ctx, CloudService_GetInstanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) ListLoadBalancers(ctx context.Context, in *ListLoadBalancersRequest, opts ...grpc.CallOption) (*ListLoadBalancersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoadBalancersResponse)
+	err := c.cc.Invoke(ctx, CloudService_ListLoadBalancers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CloudServiceServer is the server API for CloudService service.
+// All implementations should embed UnimplementedCloudServiceServer
+// for forward compatibility.
+//
+// 云平台工具服务定义
+type CloudServiceServer interface {
+	// 列出EC2实例
+	ListEC2Instances(context.Context, *ListEC2InstancesRequest) (*ListEC2InstancesResponse, error)
+	// 启动EC2实例
+	StartEC2Instance(context.Context, *StartEC2InstanceRequest) (*EC2OperationResponse, error)
+	// 停止EC2实例
+	StopEC2Instance(context.Context, *StopEC2InstanceRequest) (*EC2OperationResponse, error)
+	// 重启EC2实例
+	RestartEC2Instance(context.Context, *RestartEC2InstanceRequest) (*EC2OperationResponse, error)
+	// 获取实例状态
+	GetInstanceStatus(context.Context, *GetInstanceStatusRequest) (*InstanceStatusResponse, error)
+	// 列出负载均衡器
+	ListLoadBalancers(context.Context, *ListLoadBalancersRequest) (*ListLoadBalancersResponse, error)
+}
+
+// UnimplementedCloudServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCloudServiceServer struct{}
+
+func (UnimplementedCloudServiceServer) ListEC2Instances(context.Context, *ListEC2InstancesRequest) (*ListEC2InstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEC2Instances not implemented")
+}
+func (UnimplementedCloudServiceServer) StartEC2Instance(context.Context, *StartEC2InstanceRequest) (*EC2OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEC2Instance not implemented")
+}
+func (UnimplementedCloudServiceServer) StopEC2Instance(context.Context, *StopEC2InstanceRequest) (*EC2OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopEC2Instance not implemented")
+}
+func (UnimplementedCloudServiceServer) RestartEC2Instance(context.Context, *RestartEC2InstanceRequest) (*EC2OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartEC2Instance not implemented")
+}
+func (UnimplementedCloudServiceServer) GetInstanceStatus(context.Context, *GetInstanceStatusRequest) (*InstanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceStatus not implemented")
+}
+func (UnimplementedCloudServiceServer) ListLoadBalancers(context.Context, *ListLoadBalancersRequest) (*ListLoadBalancersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoadBalancers not implemented")
+}
+func (UnimplementedCloudServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCloudServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CloudServiceServer will
+// result in compilation errors.
+type UnsafeCloudServiceServer interface {
+	mustEmbedUnimplementedCloudServiceServer()
+}
+
+func RegisterCloudServiceServer(s grpc.ServiceRegistrar, srv CloudServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCloudServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CloudService_ServiceDesc, srv)
+}
+
+func _CloudService_ListEC2Instances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEC2InstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).ListEC2Instances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_ListEC2Instances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).ListEC2Instances(ctx, req.(*ListEC2InstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_StartEC2Instance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEC2InstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).StartEC2Instance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_StartEC2Instance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).StartEC2Instance(ctx, req.(*StartEC2InstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_StopEC2Instance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopEC2InstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).StopEC2Instance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_StopEC2Instance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).StopEC2Instance(ctx, req.(*StopEC2InstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_RestartEC2Instance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartEC2InstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).RestartEC2Instance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_RestartEC2Instance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).RestartEC2Instance(ctx, req.(*RestartEC2InstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_GetInstanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).GetInstanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_GetInstanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).GetInstanceStatus(ctx, req.(*GetInstanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_ListLoadBalancers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoadBalancersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).ListLoadBalancers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_ListLoadBalancers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).ListLoadBalancers(ctx, req.(*ListLoadBalancersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CloudService_ServiceDesc is the grpc.ServiceDesc for CloudService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CloudService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai_sre.mcp.v1.CloudService",
+	HandlerType: (*CloudServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEC2Instances",
+			Handler:    _CloudService_ListEC2Instances_Handler,
+		},
+		{
+			MethodName: "StartEC2Instance",
+			Handler:    _CloudService_StartEC2Instance_Handler,
+		},
+		{
+			MethodName: "StopEC2Instance",
+			Handler:    _CloudService_StopEC2Instance_Handler,
+		},
+		{
+			MethodName: "RestartEC2Instance",
+			Handler:    _CloudService_RestartEC2Instance_Handler,
+		},
+		{
+			MethodName: "GetInstanceStatus",
+			Handler:    _CloudService_GetInstanceStatus_Handler,
+		},
+		{
+			MethodName: "ListLoadBalancers",
+			Handler:    _CloudService_ListLoadBalancers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/mcp/mcp.proto",
+}
+
+const (
+	ContainerService_ListPods_FullMethodName        = "/ai_sre.mcp.v1.ContainerService/ListPods"
+	ContainerService_GetPod_FullMethodName          = "/ai_sre.mcp.v1.ContainerService/GetPod"
+	ContainerService_ScaleDeployment_FullMethodName = "/ai_sre.mcp.v1.ContainerService/ScaleDeployment"
+	ContainerService_GetPodLogs_FullMethodName      = "/ai_sre.mcp.v1.ContainerService/GetPodLogs"
+	ContainerService_StreamPodLogs_FullMethodName   = "/ai_sre.mcp.v1.ContainerService/StreamPodLogs"
+	ContainerService_ExecPodCommand_FullMethodName  = "/ai_sre.mcp.v1.ContainerService/ExecPodCommand"
+	ContainerService_ListDeployments_FullMethodName = "/ai_sre.mcp.v1.ContainerService/ListDeployments"
+	ContainerService_ListServices_FullMethodName    = "/ai_sre.mcp.v1.ContainerService/ListServices"
+)
+
+// ContainerServiceClient is the client API for ContainerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 容器工具服务定义
+type ContainerServiceClient interface {
+	// 列出Pod
+	ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
+	// 获取Pod详情
+	GetPod(ctx context.Context, in *GetPodRequest, opts ...grpc.CallOption) (*Pod, error)
+	// 扩缩容Deployment
+	ScaleDeployment(ctx context.Context, in *ScaleDeploymentRequest, opts ...grpc.CallOption) (*ScaleDeploymentResponse, error)
+	// 获取Pod日志
+	GetPodLogs(ctx context.Context, in *GetPodLogsRequest, opts ...grpc.CallOption) (*GetPodLogsResponse, error)
+	// 流式获取Pod日志
+	StreamPodLogs(ctx context.Context, in *StreamPodLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error)
+	// 执行Pod命令
+	ExecPodCommand(ctx context.Context, in *ExecPodCommandRequest, opts ...grpc.CallOption) (*ExecPodCommandResponse, error)
+	// 列出Deployment
+	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	// 列出Service
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+}
+
+type containerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContainerServiceClient(cc grpc.ClientConnInterface) ContainerServiceClient {
+	return &containerServiceClient{cc}
+}
+
+func (c *containerServiceClient) ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPodsResponse)
+	err := c.cc.Invoke(ctx, ContainerService_ListPods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) GetPod(ctx context.Context, in *GetPodRequest, opts ...grpc.CallOption) (*Pod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pod)
+	err := c.cc.Invoke(ctx, ContainerService_GetPod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) ScaleDeployment(ctx context.Context, in *ScaleDeploymentRequest, opts ...grpc.CallOption) (*ScaleDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleDeploymentResponse)
+	err := c.cc.Invoke(ctx, ContainerService_ScaleDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) GetPodLogs(ctx context.Context, in *GetPodLogsRequest, opts ...grpc.CallOption) (*GetPodLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPodLogsResponse)
+	err := c.cc.Invoke(ctx, ContainerService_GetPodLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) StreamPodLogs(ctx context.Context, in *StreamPodLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContainerService_ServiceDesc.Streams[0], ContainerService_StreamPodLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPodLogsRequest, LogEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContainerService_StreamPodLogsClient = grpc.ServerStreamingClient[LogEntry]
+
+func (c *containerServiceClient) ExecPodCommand(ctx context.Context, in *ExecPodCommandRequest, opts ...grpc.CallOption) (*ExecPodCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecPodCommandResponse)
+	err := c.cc.Invoke(ctx, ContainerService_ExecPodCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeploymentsResponse)
+	err := c.cc.Invoke(ctx, ContainerService_ListDeployments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, ContainerService_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContainerServiceServer is the server API for ContainerService service.
+// All implementations should embed UnimplementedContainerServiceServer
+// for forward compatibility.
+//
+// 容器工具服务定义
+type ContainerServiceServer interface {
+	// 列出Pod
+	ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
+	// 获取Pod详情
+	GetPod(context.Context, *GetPodRequest) (*Pod, error)
+	// 扩缩容Deployment
+	ScaleDeployment(context.Context, *ScaleDeploymentRequest) (*ScaleDeploymentResponse, error)
+	// 获取Pod日志
+	GetPodLogs(context.Context, *GetPodLogsRequest) (*GetPodLogsResponse, error)
+	// 流式获取Pod日志
+	StreamPodLogs(*StreamPodLogsRequest, grpc.ServerStreamingServer[LogEntry]) error
+	// 执行Pod命令
+	ExecPodCommand(context.Context, *ExecPodCommandRequest) (*ExecPodCommandResponse, error)
+	// 列出Deployment
+	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	// 列出Service
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+}
+
+// UnimplementedContainerServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedContainerServiceServer struct{}
+
+func (UnimplementedContainerServiceServer) ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPods not implemented")
+}
+func (UnimplementedContainerServiceServer) GetPod(context.Context, *GetPodRequest) (*Pod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPod not implemented")
+}
+func (UnimplementedContainerServiceServer) ScaleDeployment(context.Context, *ScaleDeploymentRequest) (*ScaleDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleDeployment not implemented")
+}
+func (UnimplementedContainerServiceServer) GetPodLogs(context.Context, *GetPodLogsRequest) (*GetPodLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPodLogs not implemented")
+}
+func (UnimplementedContainerServiceServer) StreamPodLogs(*StreamPodLogsRequest, grpc.ServerStreamingServer[LogEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPodLogs not implemented")
+}
+func (UnimplementedContainerServiceServer) ExecPodCommand(context.Context, *ExecPodCommandRequest) (*ExecPodCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecPodCommand not implemented")
+}
+func (UnimplementedContainerServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (UnimplementedContainerServiceServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedContainerServiceServer) testEmbeddedByValue() {}
+
+// UnsafeContainerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContainerServiceServer will
+// result in compilation errors.
+type UnsafeContainerServiceServer interface {
+	mustEmbedUnimplementedContainerServiceServer()
+}
+
+func RegisterContainerServiceServer(s grpc.ServiceRegistrar, srv ContainerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedContainerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ContainerService_ServiceDesc, srv)
+}
+
+func _ContainerService_ListPods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).ListPods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_ListPods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).ListPods(ctx, req.(*ListPodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_GetPod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).GetPod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_GetPod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).GetPod(ctx, req.(*GetPodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_ScaleDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).ScaleDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_ScaleDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).ScaleDeployment(ctx, req.(*ScaleDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_GetPodLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPodLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).GetPodLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_GetPodLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).GetPodLogs(ctx, req.(*GetPodLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_StreamPodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPodLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServiceServer).StreamPodLogs(m, &grpc.GenericServerStream[StreamPodLogsRequest, LogEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContainerService_StreamPodLogsServer = grpc.ServerStreamingServer[LogEntry]
+
+func _ContainerService_ExecPodCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecPodCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).ExecPodCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_ExecPodCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).ExecPodCommand(ctx, req.(*ExecPodCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).ListDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_ListDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).ListDeployments(ctx, req.(*ListDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContainerService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServiceServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContainerService_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServiceServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContainerService_ServiceDesc is the grpc.ServiceDesc for ContainerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContainerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai_sre.mcp.v1.ContainerService",
+	HandlerType: (*ContainerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPods",
+			Handler:    _ContainerService_ListPods_Handler,
+		},
+		{
+			MethodName: "GetPod",
+			Handler:    _ContainerService_GetPod_Handler,
+		},
+		{
+			MethodName: "ScaleDeployment",
+			Handler:    _ContainerService_ScaleDeployment_Handler,
+		},
+		{
+			MethodName: "GetPodLogs",
+			Handler:    _ContainerService_GetPodLogs_Handler,
+		},
+		{
+			MethodName: "ExecPodCommand",
+			Handler:    _ContainerService_ExecPodCommand_Handler,
+		},
+		{
+			MethodName: "ListDeployments",
+			Handler:    _ContainerService_ListDeployments_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _ContainerService_ListServices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPodLogs",
+			Handler:       _ContainerService_StreamPodLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/mcp/mcp.proto",
+}
+
+const (
+	DatabaseService_ExecuteQuery_FullMethodName     = "/ai_sre.mcp.v1.DatabaseService/ExecuteQuery"
+	DatabaseService_TestConnection_FullMethodName   = "/ai_sre.mcp.v1.DatabaseService/TestConnection"
+	DatabaseService_ListConnections_FullMethodName  = "/ai_sre.mcp.v1.DatabaseService/ListConnections"
+	DatabaseService_GetTableSchema_FullMethodName   = "/ai_sre.mcp.v1.DatabaseService/GetTableSchema"
+	DatabaseService_GetDatabaseStats_FullMethodName = "/ai_sre.mcp.v1.DatabaseService/GetDatabaseStats"
+)
+
+// DatabaseServiceClient is the client API for DatabaseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 数据库工具服务定义
+type DatabaseServiceClient interface {
+	// 执行查询
+	ExecuteQuery(ctx context.Context, in *ExecuteQueryRequest, opts ...grpc.CallOption) (*ExecuteQueryResponse, error)
+	// 测试连接
+	TestConnection(ctx context.Context, in *TestConnectionRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error)
+	// 获取连接列表
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	// 获取表结构
+	GetTableSchema(ctx context.Context, in *GetTableSchemaRequest, opts ...grpc.CallOption) (*GetTableSchemaResponse, error)
+	// 获取数据库统计信息
+	GetDatabaseStats(ctx context.Context, in *GetDatabaseStatsRequest, opts ...grpc.CallOption) (*GetDatabaseStatsResponse, error)
+}
+
+type databaseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseServiceClient(cc grpc.ClientConnInterface) DatabaseServiceClient {
+	return &databaseServiceClient{cc}
+}
+
+func (c *databaseServiceClient) ExecuteQuery(ctx context.Context, in *ExecuteQueryRequest, opts ...grpc.CallOption) (*ExecuteQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteQueryResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ExecuteQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) TestConnection(ctx context.Context, in *TestConnectionRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestConnectionResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_TestConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetTableSchema(ctx context.Context, in *GetTableSchemaRequest, opts ...grpc.CallOption) (*GetTableSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTableSchemaResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_GetTableSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetDatabaseStats(ctx context.Context, in *GetDatabaseStatsRequest, opts ...grpc.CallOption) (*GetDatabaseStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDatabaseStatsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_GetDatabaseStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseServiceServer is the server API for DatabaseService service.
+// All implementations should embed UnimplementedDatabaseServiceServer
+// for forward compatibility.
+//
+// 数据库工具服务定义
+type DatabaseServiceServer interface {
+	// 执行查询
+	ExecuteQuery(context.Context, *ExecuteQueryRequest) (*ExecuteQueryResponse, error)
+	// 测试连接
+	TestConnection(context.Context, *TestConnectionRequest) (*TestConnectionResponse, error)
+	// 获取连接列表
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	// 获取表结构
+	GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error)
+	// 获取数据库统计信息
+	GetDatabaseStats(context.Context, *GetDatabaseStatsRequest) (*GetDatabaseStatsResponse, error)
+}
+
+// UnimplementedDatabaseServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDatabaseServiceServer struct{}
+
+func (UnimplementedDatabaseServiceServer) ExecuteQuery(context.Context, *ExecuteQueryRequest) (*ExecuteQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQuery not implemented")
+}
+func (UnimplementedDatabaseServiceServer) TestConnection(context.Context, *TestConnectionRequest) (*TestConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestConnection not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedDatabaseServiceServer) GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTableSchema not implemented")
+}
+func (UnimplementedDatabaseServiceServer) GetDatabaseStats(context.Context, *GetDatabaseStatsRequest) (*GetDatabaseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseStats not implemented")
+}
+func (UnimplementedDatabaseServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseServiceServer will
+// result in compilation errors.
+type UnsafeDatabaseServiceServer interface {
+	mustEmbedUnimplementedDatabaseServiceServer()
+}
+
+func RegisterDatabaseServiceServer(s grpc.ServiceRegistrar, srv DatabaseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDatabaseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DatabaseService_ServiceDesc, srv)
+}
+
+func _DatabaseService_ExecuteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ExecuteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ExecuteQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ExecuteQuery(ctx, req.(*ExecuteQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_TestConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).TestConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_TestConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).TestConnection(ctx, req.(*TestConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetTableSchema(ctx, req.(*GetTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetDatabaseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDatabaseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetDatabaseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDatabaseStats(ctx, req.(*GetDatabaseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DatabaseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai_sre.mcp.v1.DatabaseService",
+	HandlerType: (*DatabaseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteQuery",
+			Handler:    _DatabaseService_ExecuteQuery_Handler,
+		},
+		{
+			MethodName: "TestConnection",
+			Handler:    _DatabaseService_TestConnection_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _DatabaseService_ListConnections_Handler,
+		},
+		{
+			MethodName: "GetTableSchema",
+			Handler:    _DatabaseService_GetTableSchema_Handler,
+		},
+		{
+			MethodName: "GetDatabaseStats",
+			Handler:    _DatabaseService_GetDatabaseStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/mcp/mcp.proto",
+}
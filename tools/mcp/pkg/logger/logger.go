@@ -23,6 +23,9 @@ func Init(cfg *config.LoggingConfig) error {
 	}
 	Logger.SetLevel(level)
 	
+	// 会话内的日志经由 hook 转发给客户端
+	Logger.AddHook(sessionHook{})
+	
 	// 设置日志格式
 	switch cfg.Format {
 	case "json":
@@ -41,6 +44,11 @@ func Init(cfg *config.LoggingConfig) error {
 	}
 	
 	// 设置输出目标
+	var console io.Writer = os.Stdout
+	if cfg.Output == "stderr" {
+		console = os.Stderr
+	}
+	
 	if cfg.File != "" {
 		// 确保日志目录存在
 		if err := os.MkdirAll(filepath.Dir(cfg.File), 0755); err != nil {
@@ -53,9 +61,9 @@ func Init(cfg *config.LoggingConfig) error {
 		}
 		
 		// 同时输出到文件和控制台
-		Logger.SetOutput(io.MultiWriter(os.Stdout, file))
+		Logger.SetOutput(io.MultiWriter(console, file))
 	} else {
-		Logger.SetOutput(os.Stdout)
+		Logger.SetOutput(console)
 	}
 	
 	return nil
@@ -70,6 +78,7 @@ func GetLogger() *logrus.Logger {
 		Logger.SetFormatter(&logrus.JSONFormatter{
 			TimestampFormat: "2006-01-02 15:04:05",
 		})
+		Logger.AddHook(sessionHook{})
 	}
 	return Logger
 }
@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SessionLogFunc 接收会话日志条目的函数
+type SessionLogFunc func(entry *logrus.Entry)
+
+// sessionLogContextKey 会话日志接收函数在上下文中的键
+type sessionLogContextKey struct{}
+
+// WithSessionLog 将会话日志接收函数放入上下文
+// 之后通过 WithContext(ctx) 记录的日志除正常输出外，还会交给该函数转发给客户端
+func WithSessionLog(ctx context.Context, fn SessionLogFunc) context.Context {
+	return context.WithValue(ctx, sessionLogContextKey{}, fn)
+}
+
+// WithContext 创建绑定上下文的日志条目
+func WithContext(ctx context.Context) *logrus.Entry {
+	return GetLogger().WithContext(ctx)
+}
+
+// sessionHook 将绑定了会话日志接收函数的日志条目转发给会话
+// 只有通过服务器日志级别过滤的条目才会触发 hook
+type sessionHook struct{}
+
+// Levels 实现 logrus.Hook 接口，处理所有级别
+func (sessionHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire 实现 logrus.Hook 接口
+func (sessionHook) Fire(entry *logrus.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+	if fn, ok := entry.Context.Value(sessionLogContextKey{}).(SessionLogFunc); ok && fn != nil {
+		fn(entry)
+	}
+	return nil
+}